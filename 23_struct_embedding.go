@@ -1,6 +1,6 @@
 // Go поддерживает _встраивание_ структур и интерфейсов
 // для выражения более бесшовной _композиции_ типов.
-// Это не следует путать с [`//go:embed`](embed-directive), который является
+// Это не следует путать с [`//go:embed`](71_embed_directive.go), который является
 // директивой Go, введенной в версии 1.16+ для встраивания
 // файлов и папок в бинарный файл приложения.
 
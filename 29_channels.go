@@ -19,8 +19,22 @@ func main() {
 
 	// Синтаксис `<-канал` _получает_ значение из канала.
 	// Здесь мы получаем сообщение `"ping"`, которое отправили выше, и выводим его.
+	//
+	// По умолчанию канал _небуферизован_: отправка блокируется,
+	// пока другая горутина не будет готова получить значение, а
+	// получение — пока кто-то не отправит. Поэтому нам не нужна
+	// никакая дополнительная синхронизация: `main` дождётся `"ping"`.
 	msg := <-messages
 	fmt.Println(msg)
+
+	// Отправка в небуферизованный канал без получателя в другой
+	// горутине никогда не завершится. Если раскомментировать
+	// строку ниже, `main` заблокируется навсегда, а рантайм,
+	// обнаружив, что все горутины спят, аварийно завершит программу:
+	//
+	//	fatal error: all goroutines are asleep - deadlock!
+	//
+	// messages <- "x"
 }
 
 // В Go каналы (channels) играют ключевую роль в коммуникации и синхронизации между goroutines. Они позволяют передавать данные между goroutines и управлять потоками выполнения. Вот краткое объяснение основных аспектов работы с каналами, включая полный пример.
@@ -3,6 +3,13 @@
 // для ожидания завершения горутины.
 // Когда нужно ждать завершения нескольких горутин,
 // лучше использовать [WaitGroup](waitgroups).
+//
+// В [примере о горутинах](28_goroutines.go) `main` ждала
+// горутины с помощью `time.Sleep(time.Second)` и честно
+// признавала, что это ненадёжно: работа может занять больше
+// времени, и программа завершится раньше. Канал `done` решает
+// эту проблему детерминированно — `main` ждёт ровно до момента,
+// когда горутина сообщит о завершении, ни больше ни меньше.
 
 package main
 
@@ -30,6 +37,8 @@ func main() {
 	go worker(done)
 
 	// Блокируем выполнение, пока не получим уведомление от worker.
+	// Если убрать эту строку, программа завершится раньше,
+	// чем worker успеет начать работу.
 	<-done
 }
 
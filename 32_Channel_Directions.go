@@ -12,6 +12,13 @@ import "fmt"
 // ошибке компиляции.
 func ping(pings chan<- string, msg string) {
 	pings <- msg // Отправка сообщения в канал
+
+	// Направление канала проверяется на этапе компиляции.
+	// Если раскомментировать строку ниже, программа не соберётся:
+	//
+	//	invalid operation: cannot receive from send-only channel chan<- string pings (variable of type chan<- string)
+	//
+	// _ = <-pings
 }
 
 // Функция `pong` принимает один канал для получения данных (`pings`)
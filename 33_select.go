@@ -1,6 +1,8 @@
 // Конструкция _select_ в Go позволяет ожидать несколько операций
 // с каналами. Комбинирование горутин и каналов с `select` — мощная
-// возможность Go.
+// возможность Go. Это естественное продолжение примеров о каналах:
+// до сих пор мы всегда ждали один конкретный канал, а `select`
+// позволяет ждать сразу несколько.
 
 package main
 
@@ -29,6 +31,11 @@ func main() {
 
 	// Используем `select`, чтобы ожидать получения значений из обоих каналов
 	// одновременно, выводя каждое значение по мере его поступления.
+	//
+	// `select` блокируется, пока хотя бы один из его случаев не будет
+	// готов. Если готовы сразу несколько, выбирается случайный из них —
+	// Go специально не отдаёт предпочтения первому случаю, чтобы
+	// ни один канал не "голодал".
 	for i := 0; i < 2; i++ {
 		select {
 		case msg1 := <-c1:
@@ -2,6 +2,12 @@
 // внешним ресурсам или которые требуют ограничения
 // времени выполнения. Реализация тайм-аутов в Go проста и
 // элегантна благодаря каналам и `select`.
+//
+// Во многих языках тайм-аут прерывает операцию исключением.
+// В Go, как и в [примере об ошибках](26_errors.go), исключительная
+// ситуация — это просто ещё одно значение: тайм-аут приходит как
+// значение из канала `time.After` и обрабатывается обычным
+// случаем `select`, рядом с успешным результатом.
 
 package main
 
@@ -1,6 +1,11 @@
 // Основные операции отправки и получения на каналах являются блокирующими.
 // Однако мы можем использовать `select` с `default`-кейсом для реализации
 // _неблокирующих_ отправок, получений и даже неблокирующих многоканальных `select`.
+//
+// Случай `default` выполняется, когда ни один другой случай не готов, поэтому
+// `select` с `default` никогда не ждёт: он возвращается немедленно. Сравните
+// с [буферизованными каналами](30_channels_buffering.go), где отправка в
+// заполненный буфер заблокировала бы горутину до появления получателя.
 
 package main
 
@@ -15,9 +20,9 @@ func main() {
 	// он немедленно выберет `default`-кейс.
 	select {
 	case msg := <-messages:
-		fmt.Println("получено сообщение", msg)
+		fmt.Println("received message", msg)
 	default:
-		fmt.Println("нет полученного сообщения")
+		fmt.Println("no message received")
 	}
 
 	// Неблокирующая отправка работает аналогично. Здесь сообщение `msg`
@@ -26,9 +31,9 @@ func main() {
 	msg := "hi"
 	select {
 	case messages <- msg:
-		fmt.Println("отправлено сообщение", msg)
+		fmt.Println("sent message", msg)
 	default:
-		fmt.Println("сообщение не отправлено")
+		fmt.Println("no message sent")
 	}
 
 	// Мы можем использовать несколько `case` до `default`-кейса для
@@ -37,24 +42,24 @@ func main() {
 	// и из `signals`.
 	select {
 	case msg := <-messages:
-		fmt.Println("получено сообщение", msg)
+		fmt.Println("received message", msg)
 	case sig := <-signals:
-		fmt.Println("получен сигнал", sig)
+		fmt.Println("received signal", sig)
 	default:
-		fmt.Println("нет активности")
+		fmt.Println("no activity")
 	}
 }
 
 // Пояснение:
 // Неблокирующее получение:
 
-// В первом select используется неблокирующее получение из канала messages. Если на канале есть данные, они будут получены и выведены. Если данных нет, сразу будет выполнен default-кейс, выводящий "нет полученного сообщения".
+// В первом select используется неблокирующее получение из канала messages. Если на канале есть данные, они будут получены и выведены. Если данных нет, сразу будет выполнен default-кейс, выводящий "no message received".
 // Неблокирующая отправка:
 
-// Во втором select осуществляется попытка отправить сообщение msg в канал messages. Поскольку канал не имеет буфера и нет получателя, отправка блокировалась бы, поэтому выбирается default-кейс, выводящий "сообщение не отправлено".
+// Во втором select осуществляется попытка отправить сообщение msg в канал messages. Поскольку канал не имеет буфера и нет получателя, отправка блокировалась бы, поэтому выбирается default-кейс, выводящий "no message sent".
 // Многоканальный неблокирующий select:
 
-// В третьем select мы пытаемся выполнить неблокирующее получение из обоих каналов (messages и signals). Поскольку каналы пусты и нет активности, default-кейс выполнится и выведет "нет активности".
+// В третьем select мы пытаемся выполнить неблокирующее получение из обоих каналов (messages и signals). Поскольку каналы пусты и нет активности, default-кейс выполнится и выведет "no activity".
 
 // Неблокирующие операции позволяют избежать блокировки выполнения программы, что полезно в ситуациях, когда вы хотите проверить доступность данных или ресурсов без ожидания.
 
@@ -1,6 +1,13 @@
 // _Закрытие_ канала указывает на то, что больше значений
 // отправляться не будет. Это может быть полезно для
 // уведомления получателей канала о завершении работы.
+//
+// Без закрытия получатель не может отличить "значения ещё
+// не пришли" от "значений больше не будет" и будет ждать
+// вечно. `close()` передаёт именно второе: уже отправленные
+// в [буфер](30_channels_buffering.go) значения по-прежнему
+// можно прочитать, а после них получение сразу сообщает,
+// что канал закрыт.
 
 package main
 
@@ -18,6 +18,11 @@ func main() {
 	// Этот `range` итерирует по каждому элементу, когда он
 	// получен из канала `queue`. Поскольку канал был закрыт
 	// выше, итерация завершается после получения всех 2 элементов.
+	//
+	// Если забыть `close(queue)`, после двух элементов `range`
+	// продолжит ждать третий, которого никто не отправит, и
+	// программа упадёт с `fatal error: all goroutines are asleep - deadlock!`.
+	// Подробнее о закрытии — в [предыдущем примере](36_closing_channels.go).
 	for elem := range queue {
 		fmt.Println(elem)
 	}
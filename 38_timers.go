@@ -12,7 +12,9 @@ import (
 
 func main() {
 
-	// Таймеры представляют собой одно событие в будущем. Вы
+	// Таймеры представляют собой _одно_ событие в будущем: в
+	// отличие от тикеров, которые срабатывают снова и снова,
+	// таймер срабатывает ровно один раз. Вы
 	// указываете таймеру, сколько вы хотите подождать, и он
 	// предоставляет канал, который будет уведомлен в это
 	// время. Этот таймер будет ждать 2 секунды.
@@ -22,20 +24,23 @@ func main() {
 	// таймер не отправит значение в канал `C`, указывая на
 	// то, что таймер сработал.
 	<-timer1.C
-	fmt.Println("Таймер 1 сработал")
+	fmt.Println("Timer 1 fired")
 
 	// Если вы просто хотите подождать, вы могли бы использовать
 	// `time.Sleep`. Одна из причин, почему таймер может быть
 	// полезен, заключается в том, что вы можете отменить
-	// таймер до того, как он сработает. Вот пример этого.
+	// таймер до того, как он сработает, — например, если ответ
+	// пришёл раньше тайм-аута и ждать больше нечего. Вот пример этого.
+	// `Stop` возвращает `true`, если таймер удалось остановить до
+	// срабатывания, и `false`, если он уже сработал или был остановлен.
 	timer2 := time.NewTimer(time.Second)
 	go func() {
 		<-timer2.C
-		fmt.Println("Таймер 2 сработал")
+		fmt.Println("Timer 2 fired")
 	}()
 	stop2 := timer2.Stop()
 	if stop2 {
-		fmt.Println("Таймер 2 остановлен")
+		fmt.Println("Timer 2 stopped")
 	}
 
 	// Даем `timer2` достаточно времени, чтобы сработать, если
@@ -48,13 +53,13 @@ func main() {
 // Создание и использование таймера:
 
 // time.NewTimer(d) создает таймер, который будет срабатывать через d продолжительность времени. В этом примере timer1 настроен на 2 секунды.
-// <-timer1.C блокирует выполнение до тех пор, пока таймер не сработает и не отправит сигнал в свой канал C. После этого выполняется fmt.Println("Таймер 1 сработал").
+// <-timer1.C блокирует выполнение до тех пор, пока таймер не сработает и не отправит сигнал в свой канал C. После этого выполняется fmt.Println("Timer 1 fired").
 // Отмена таймера:
 
 // time.NewTimer(d) также позволяет создать таймер, который можно отменить до его срабатывания. В примере timer2 настроен на 1 секунду.
 // В горутине мы пытаемся получить значение из timer2.C, но до этого времени вызываем timer2.Stop(), чтобы отменить таймер.
 // stop2 := timer2.Stop() возвращает true, если таймер был успешно остановлен до его срабатывания. В противном случае он возвращает false.
-// Если таймер был успешно остановлен, выводится сообщение "Таймер 2 остановлен".
+// Если таймер был успешно остановлен, выводится сообщение "Timer 2 stopped".
 // Проверка остановленного таймера:
 
 // После попытки остановки таймера программа ждет 2 секунды с помощью time.Sleep(2 * time.Second). Это позволяет убедиться, что таймер действительно остановлен, так как timer2 не должен сработать.
@@ -30,7 +30,14 @@ func main() {
 
 	// Тикеры можно остановить так же, как таймеры. После остановки тикер
 	// не будет получать больше значений на своем канале. Мы остановим
-	// наш тикер через 1600 мс.
+	// наш тикер через 1600 мс — за это время он успеет сработать
+	// ровно три раза: на 500, 1000 и 1500 мс.
+	//
+	// Тикер, который больше не нужен, обязательно нужно остановить:
+	// иначе он продолжит работать и занимать ресурсы. При этом `Stop`
+	// не закрывает канал `ticker.C`, поэтому горутина сама по себе
+	// не узнает, что тиков больше не будет, и осталась бы
+	// заблокированной навсегда. Сигнал в канал `done` завершает её.
 	time.Sleep(1600 * time.Millisecond)
 	ticker.Stop()
 	done <- true
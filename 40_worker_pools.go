@@ -1,5 +1,10 @@
 // В этом примере мы рассмотрим, как реализовать
 // _пул рабочих_ с использованием горутин и каналов.
+//
+// До сих пор каждая задача получала свою горутину. Пул — это
+// следующий шаг: фиксированное число горутин разбирает общую
+// очередь заданий, поэтому одновременно выполняется не больше
+// заданий, чем рабочих, сколько бы заданий ни пришло.
 
 package main
 
@@ -11,12 +16,12 @@ import (
 // Вот функция `worker`, которая будет выполнена несколькими
 // параллельными экземплярами. Эти рабочие будут получать
 // задания на канале `jobs` и отправлять соответствующие
-// результаты на канал `results`. Мы используем задержку в
-// одну секунду на задание, чтобы смоделировать дорогую задачу.
+// результаты на канал `results`. Мы используем небольшую
+// фиксированную задержку на задание, чтобы смоделировать дорогую задачу.
 func worker(id int, jobs <-chan int, results chan<- int) {
 	for j := range jobs {
 		fmt.Println("worker", id, "started job", j)
-		time.Sleep(time.Second)
+		time.Sleep(100 * time.Millisecond)
 		fmt.Println("worker", id, "finished job", j)
 		results <- j * 2
 	}
@@ -26,7 +31,7 @@ func main() {
 
 	// Для использования нашего пула рабочих нам нужно отправить
 	// им задания и собрать их результаты. Мы создаем 2 канала для этого.
-	const numJobs = 5
+	const numJobs = 9
 	jobs := make(chan int, numJobs)
 	results := make(chan int, numJobs)
 
@@ -36,14 +41,15 @@ func main() {
 		go worker(w, jobs, results)
 	}
 
-	// Отправляем 5 заданий и затем закрываем этот
+	// Отправляем 9 заданий и затем закрываем этот
 	// канал, чтобы указать, что это все работы.
 	for j := 1; j <= numJobs; j++ {
 		jobs <- j
 	}
 	close(jobs)
 
-	// Наконец, собираем все результаты работы.
+	// Наконец, собираем все результаты работы. Их ровно столько же,
+	// сколько заданий, независимо от того, какой рабочий что выполнил.
 	// Это также гарантирует, что горутины-рабочие завершили свою работу.
 	// Альтернативный способ ожидания завершения нескольких
 	// горутин - использовать [WaitGroup](waitgroups).
@@ -57,14 +63,14 @@ func main() {
 
 // Функция worker получает идентификатор рабочего, канал для получения заданий (jobs) и канал для отправки результатов (results).
 // Она использует бесконечный цикл for для получения заданий из канала jobs и обработки их.
-// В данном примере выполнение задания симулируется с помощью time.Sleep(time.Second), а затем результат (вдвое увеличенное значение задания) отправляется в канал results.
+// В данном примере выполнение задания симулируется с помощью time.Sleep(100 * time.Millisecond), а затем результат (вдвое увеличенное значение задания) отправляется в канал results.
 // Создание и запуск рабочих:
 
 // В функции main создаются два канала: jobs для передачи заданий рабочим и results для получения результатов.
 // Запускаются три горутины с функцией worker, каждая из которых будет ожидать задания и выполнять их.
 // Отправка заданий:
 
-// В цикле for отправляются пять заданий в канал jobs.
+// В цикле for отправляются девять заданий в канал jobs.
 // После отправки всех заданий канал jobs закрывается с помощью close(jobs), чтобы указать рабочим, что больше нет заданий.
 // Сбор результатов:
 
@@ -1,3 +1,9 @@
+// Чтобы дождаться завершения нескольких горутин, можно
+// использовать _wait group_. Это идиоматичная замена
+// `time.Sleep`, с помощью которого `main` ждала горутины в
+// [примере о горутинах](28_goroutines.go): вместо того чтобы
+// угадывать время, мы точно знаем, когда все горутины закончили.
+
 package main
 
 import (
@@ -25,11 +31,18 @@ func main() {
 	// Запускаем несколько горутин и увеличиваем счетчик
 	// WaitGroup для каждой из них.
 	for i := 1; i <= 5; i++ {
+		// `Add` вызывается до `go`, в запускающей горутине.
+		// Классическая ошибка — вызвать `wg.Add(1)` внутри новой
+		// горутины: тогда `wg.Wait()` может выполниться раньше,
+		// чем горутина успеет увеличить счётчик, и вернуться сразу.
 		wg.Add(1)
 
 		// Обертываем вызов worker в замыкание, чтобы гарантировать,
 		// что WaitGroup уведомляется о завершении работы. Таким образом,
 		// сама функция worker не обязана знать о механизмах конкурентности.
+		// Замыкание захватывает `wg` по ссылке. Если бы мы передали
+		// `wg` в функцию параметром типа `sync.WaitGroup` (по значению),
+		// `Done` уменьшал бы счётчик копии, и `wg.Wait()` не вернулся бы никогда.
 		go func(i int) {
 			defer wg.Done()
 			worker(i)
@@ -23,7 +23,9 @@ func main() {
 
 	// Этот канал `limiter` будет получать значение
 	// каждые 200 миллисекунд. Это регулятор в нашей схеме
-	// ограничения частоты запросов.
+	// ограничения частоты запросов. `time.Tick` — это просто
+	// канал `C` [тикера](39_tickers.go) без возможности его остановить,
+	// что допустимо, когда тикер нужен до конца работы программы.
 	limiter := time.Tick(200 * time.Millisecond)
 
 	// Блокируя получение значения из канала `limiter`
@@ -39,6 +41,12 @@ func main() {
 	// общий лимит запросов. Мы можем сделать это, добавив буфер
 	// в наш канал `limiter`. Этот канал `burstyLimiter` позволит
 	// обрабатывать до 3 запросов за один раз.
+	//
+	// Разница между подходами: при равномерном ограничении запросы
+	// всегда идут с интервалом 200 мс, даже если до этого система
+	// простаивала. При ограничении со всплесками простой копит
+	// "запас" (до размера буфера), который можно потратить сразу,
+	// а средняя частота при этом остаётся той же.
 	burstyLimiter := make(chan time.Time, 3)
 
 	// Заполним канал значениями, чтобы позволить "всплеск" запросов.
@@ -31,6 +31,14 @@ func main() {
 			for c := 0; c < 1000; c++ {
 
 				// Для атомарного увеличения счётчика используем `Add`.
+				//
+				// С обычным `uint64` и `ops++` результат был бы
+				// непредсказуем: `++` — это три шага (прочитать,
+				// прибавить, записать), и горутины перезаписывали бы
+				// изменения друг друга. Это _гонка данных_; запустите
+				// такой вариант через `go run -race`, и детектор гонок
+				// сообщит о ней. Атомарный `Add` выполняет все три шага
+				// как одну неделимую операцию — без мьютекса.
 				ops.Add(1)
 			}
 
@@ -1,5 +1,76 @@
+// В предыдущем примере мы видели, как управлять простым
+// счётчиком с помощью [атомарных операций](43_atomic_counters.go).
+// Для более сложного состояния мы можем использовать
+// [_мьютекс_](https://en.wikipedia.org/wiki/Mutual_exclusion),
+// чтобы безопасно обращаться к данным из нескольких горутин.
+
 package main
 
+import (
+	"fmt"
+	"sync"
+)
+
+// `Container` хранит карту счётчиков. Атомарные операции
+// здесь не помогут: запись в карту — составная операция над
+// внутренней структурой карты, а одновременная запись из
+// нескольких горутин приводит к аварийному завершению программы.
+// Поэтому доступ к карте защищается мьютексом.
+//
+// Мьютекс встроен в структуру так же, как в [примере о
+// встраивании структур](23_struct_embedding.go): методы `Lock`
+// и `Unlock` становятся методами `Container`. Обратите
+// внимание, что мьютекс нельзя копировать, поэтому
+// `Container` передаётся по указателю.
+type Container struct {
+	sync.Mutex
+	counters map[string]int
+}
+
+// Блокируем мьютекс перед обращением к `counters` и
+// разблокируем его в конце функции с помощью `defer`.
+func (c *Container) inc(name string) {
+	c.Lock()
+	defer c.Unlock()
+	c.counters[name]++
+}
+
 func main() {
+	// Нулевое значение мьютекса готово к использованию,
+	// поэтому инициализировать его не нужно.
+	c := Container{
+		counters: map[string]int{"a": 0, "b": 0},
+	}
+
+	var wg sync.WaitGroup
 
+	// Эта функция увеличивает именованный счётчик
+	// в цикле.
+	doIncrement := func(name string, n int) {
+		for i := 0; i < n; i++ {
+			c.inc(name)
+		}
+		wg.Done()
+	}
+
+	// Запускаем несколько горутин одновременно; обратите
+	// внимание, что все они обращаются к одному и тому же
+	// `Container`, а две из них — к одному и тому же счётчику.
+	wg.Add(3)
+	go doIncrement("a", 10000)
+	go doIncrement("a", 10000)
+	go doIncrement("b", 10000)
+
+	// Ждём завершения горутин.
+	wg.Wait()
+	fmt.Println(c.counters)
 }
+
+// Пояснения:
+// Когда нужен мьютекс: атомарные операции подходят для отдельных чисел, а мьютекс — для составного состояния, например карты или нескольких связанных полей, которые нужно менять вместе.
+
+// Встраивание sync.Mutex: благодаря встраиванию можно писать c.Lock() вместо c.mu.Lock(). Это тот же механизм продвижения методов, что и в примере о встраивании структур.
+
+// defer c.Unlock(): разблокировка через defer гарантирует, что мьютекс будет освобождён при любом выходе из функции, в том числе при панике.
+
+// Результат: после завершения всех горутин счётчик "a" равен 20000, а "b" — 10000, независимо от порядка выполнения горутин.
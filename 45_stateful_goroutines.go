@@ -0,0 +1,113 @@
+// В предыдущем примере мы использовали явную блокировку
+// с помощью [мьютексов](44_mutexes.go), чтобы синхронизировать
+// доступ к общему состоянию из нескольких горутин. Другой вариант —
+// использовать встроенные средства синхронизации горутин и
+// каналов. Такой подход соответствует идее Go о разделении
+// памяти через общение: "не общайтесь через разделяемую
+// память; разделяйте память, общаясь" — и о том, что каждым
+// фрагментом данных владеет ровно одна горутина.
+
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"sync/atomic"
+	"time"
+)
+
+// В этом примере состоянием владеет одна горутина. Это
+// гарантирует, что данные никогда не будут повреждены
+// одновременным доступом. Чтобы прочитать или записать
+// состояние, другие горутины отправляют горутине-владельцу
+// сообщения и получают ответы. Структуры `readOp` и `writeOp`
+// описывают эти запросы и содержат канал `resp`, через
+// который владелец отвечает.
+type readOp struct {
+	key  int
+	resp chan int
+}
+type writeOp struct {
+	key  int
+	val  int
+	resp chan bool
+}
+
+func main() {
+
+	// Как и в примере об атомарных счётчиках, будем считать,
+	// сколько операций мы выполнили.
+	var readOps atomic.Uint64
+	var writeOps atomic.Uint64
+
+	// Каналы `reads` и `writes` используются другими горутинами
+	// для отправки запросов на чтение и запись.
+	reads := make(chan readOp)
+	writes := make(chan writeOp)
+
+	// Это горутина, которая владеет `state` — картой, как
+	// в примере с мьютексами, но здесь она приватна для этой
+	// горутины. Горутина в цикле выбирает с помощью `select`
+	// поступающие запросы и отвечает на них: для чтения отправляет
+	// значение в `resp`, для записи — `true`, чтобы подтвердить успех.
+	go func() {
+		var state = make(map[int]int)
+		for {
+			select {
+			case read := <-reads:
+				read.resp <- state[read.key]
+			case write := <-writes:
+				state[write.key] = write.val
+				write.resp <- true
+			}
+		}
+	}()
+
+	// Запускаем 100 горутин, которые отправляют запросы на чтение
+	// горутине-владельцу через канал `reads`. Каждое чтение
+	// требует создать `readOp`, отправить его и дождаться ответа
+	// из канала `resp`.
+	for r := 0; r < 100; r++ {
+		go func() {
+			for {
+				read := readOp{
+					key:  rand.Intn(5),
+					resp: make(chan int)}
+				reads <- read
+				<-read.resp
+				readOps.Add(1)
+				time.Sleep(time.Millisecond)
+			}
+		}()
+	}
+
+	// Так же запускаем 10 горутин-писателей.
+	for w := 0; w < 10; w++ {
+		go func() {
+			for {
+				write := writeOp{
+					key:  rand.Intn(5),
+					val:  rand.Intn(100),
+					resp: make(chan bool)}
+				writes <- write
+				<-write.resp
+				writeOps.Add(1)
+				time.Sleep(time.Millisecond)
+			}
+		}()
+	}
+
+	// Даём горутинам поработать одну секунду.
+	time.Sleep(time.Second)
+
+	// Наконец, считываем и выводим счётчики операций.
+	fmt.Println("readOps:", readOps.Load())
+	fmt.Println("writeOps:", writeOps.Load())
+}
+
+// Пояснения:
+// Сравнение с мьютексами: в примере 44_mutexes.go карта защищалась блокировкой, и любая горутина могла обратиться к ней напрямую. Здесь к карте обращается только одна горутина, а остальные посылают ей запросы через каналы. Блокировки не нужны вовсе.
+
+// Когда что выбирать: подход с горутиной-владельцем сложнее и медленнее для простых случаев, но удобен, когда уже есть другие каналы или когда управление несколькими мьютексами становится запутанным. Используйте тот вариант, который делает программу понятнее.
+
+// Вывод: точные числа операций зависят от планировщика и машины, но за секунду выполняется порядка десятков тысяч чтений и нескольких тысяч записей.
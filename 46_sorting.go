@@ -0,0 +1,37 @@
+// Пакет `slices` в Go реализует сортировку для встроенных
+// и пользовательских типов. Сначала рассмотрим сортировку
+// встроенных типов.
+
+package main
+
+import (
+	"fmt"
+	"slices"
+)
+
+func main() {
+
+	// Функции сортировки обобщённые и работают с любым
+	// _упорядоченным_ встроенным типом — для них в пакете
+	// [cmp](https://pkg.go.dev/cmp#Ordered) определено ограничение
+	// `cmp.Ordered`. Это типы, значения которых можно сравнивать
+	// операторами `<` и `>`: числа и строки.
+	strs := []string{"c", "a", "b"}
+	slices.Sort(strs)
+	fmt.Println("Strings:", strs)
+
+	// Пример сортировки `int`.
+	ints := []int{7, 2, 4}
+	slices.Sort(ints)
+	fmt.Println("Ints:   ", ints)
+
+	// С помощью пакета `slices` также можно проверить,
+	// отсортирован ли срез.
+	s := slices.IsSorted(ints)
+	fmt.Println("Sorted: ", s)
+
+	// `slices.Sort` сортирует срез на месте, а не возвращает новый.
+	// Для типов без естественного порядка (например, структур) или
+	// когда нужен другой порядок, используется сортировка с
+	// функцией сравнения — это тема [следующего примера](47_sorting_by_functions.go).
+}
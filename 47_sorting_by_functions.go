@@ -0,0 +1,61 @@
+// Иногда нужно отсортировать коллекцию не в естественном
+// порядке. Например, отсортировать строки по длине, а не
+// по алфавиту. Вот пример пользовательской сортировки в Go.
+
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+func main() {
+	fruits := []string{"peach", "banana", "kiwi", "apple", "fig"}
+
+	// Функция сравнения принимает два элемента `a` и `b` и
+	// возвращает отрицательное число, если `a` должен идти раньше
+	// `b`, ноль, если они равны с точки зрения порядка, и
+	// положительное число, если `a` должен идти позже.
+	//
+	// Вспомогательная функция [cmp.Compare](https://pkg.go.dev/cmp#Compare)
+	// возвращает ровно такие значения (-1, 0 или +1) для любых
+	// упорядоченных типов. Здесь мы сравниваем длины строк.
+	lenCmp := func(a, b string) int {
+		return cmp.Compare(len(a), len(b))
+	}
+
+	// `slices.SortFunc` сортирует срез с помощью нашей функции.
+	// Эта сортировка _нестабильная_: строки одинаковой длины
+	// ("peach" и "apple") могут оказаться в любом порядке.
+	slices.SortFunc(fruits, lenCmp)
+	fmt.Println(fruits)
+
+	// `slices.SortStableFunc` гарантирует, что равные элементы
+	// сохранят исходный взаимный порядок: "peach" стоит раньше
+	// "apple" во входном срезе и останется раньше в результате.
+	fruits = []string{"peach", "banana", "kiwi", "apple", "fig"}
+	slices.SortStableFunc(fruits, lenCmp)
+	fmt.Println(fruits)
+
+	// Тот же приём работает для срезов значений, которые не
+	// являются встроенными типами, — например, структур `person`,
+	// как в примере о структурах.
+	type person struct {
+		name string
+		age  int
+	}
+
+	people := []person{
+		{name: "Jax", age: 37},
+		{name: "TJ", age: 25},
+		{name: "Alex", age: 72},
+	}
+
+	// Сортируем `people` по возрасту.
+	slices.SortFunc(people,
+		func(a, b person) int {
+			return cmp.Compare(a.age, b.age)
+		})
+	fmt.Println(people)
+}
@@ -0,0 +1,56 @@
+// `panic` обычно означает, что что-то пошло неожиданно
+// не так. Чаще всего мы используем его, чтобы быстро
+// завершить программу при ошибках, которые не должны
+// возникать при нормальной работе или которые мы не
+// готовы корректно обработать.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Какой случай показать, выбирается аргументом командной строки:
+//
+//	$ go run 48_panic.go        # явная паника со своим значением
+//	$ go run 48_panic.go file   # паника при ошибке создания файла
+func main() {
+
+	// Выведем что-нибудь до паники: этот вывод появится
+	// перед трассировкой стека.
+	fmt.Println("starting")
+
+	if len(os.Args) > 1 && os.Args[1] == "file" {
+		createOrPanic()
+	}
+
+	// Паниковать можно с произвольным значением — здесь это
+	// строка. `panic` прекращает нормальное выполнение функции и
+	// начинает _раскручивать стек_: текущая функция и все
+	// вызвавшие её завершаются одна за другой, пока программа
+	// не остановится с сообщением и трассировкой стека
+	// горутины, а код возврата будет ненулевым.
+	panic("a problem")
+}
+
+// Типичное использование паники — прервать работу, если
+// функция вернула ошибку, которую мы не знаем (или не хотим)
+// как обработать. Вот пример паники при неожиданной ошибке
+// создания файла: каталога `no-such-dir` не существует.
+func createOrPanic() {
+	path := filepath.Join(os.TempDir(), "no-such-dir", "file")
+	_, err := os.Create(path)
+	if err != nil {
+		fmt.Println("create failed, panicking")
+		panic(err)
+	}
+}
+
+// Пояснения:
+// Паника против ошибок: в отличие от языков, где исключения используются для обработки многих ошибок, в Go идиоматично возвращать ошибки как значения (см. 26_errors.go) везде, где это возможно. Вызывающий код сам решает, что с ними делать.
+
+// Когда уместна паника: для действительно неожиданных состояний — нарушенных инвариантов, ошибок программиста, невозможности продолжать работу при старте программы. Это не способ сообщать об обычных ошибках вроде отсутствующего файла в рабочем коде.
+
+// Вывод программы: без аргументов печатается "starting", затем "panic: a problem", трассировка стека и "exit status 2". С аргументом file после "starting" идёт "create failed, panicking" и сообщение вида "panic: open /tmp/no-such-dir/file: no such file or directory".
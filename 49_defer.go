@@ -0,0 +1,71 @@
+// _Defer_ используется, чтобы гарантировать, что вызов
+// функции будет выполнен позже, обычно для освобождения
+// ресурсов. `defer` часто используется там, где в других
+// языках применяются `ensure` и `finally`.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Предположим, мы хотим создать файл, записать в него
+// и закрыть по окончании работы. Вот как это можно сделать
+// с помощью `defer`.
+func main() {
+
+	// Сразу после получения файла с помощью `createFile`
+	// мы откладываем его закрытие с помощью `closeFile`.
+	// Отложенный вызов выполнится в конце объемлющей
+	// функции (`main`), после того как отработает `writeFile`.
+	// Отложенные вызовы выполняются даже при панике, поэтому
+	// файл будет закрыт в любом случае.
+	path := filepath.Join(os.TempDir(), "defer.txt")
+	f := createFile(path)
+	defer os.Remove(path)
+	defer closeFile(f)
+	writeFile(f)
+
+	deferOrder()
+}
+
+func createFile(p string) *os.File {
+	fmt.Println("creating")
+	f, err := os.Create(p)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+func writeFile(f *os.File) {
+	fmt.Println("writing")
+	fmt.Fprintln(f, "data")
+}
+
+// Важно проверять ошибки при закрытии файла, даже в
+// отложенной функции. Частая ошибка — писать просто
+// `defer f.Close()` и молча терять ошибку: для записанного
+// файла `Close` может сообщить, что данные не удалось сохранить.
+func closeFile(f *os.File) {
+	fmt.Println("closing")
+	err := f.Close()
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// Если в функции несколько отложенных вызовов, они выполняются
+// в обратном порядке — последним пришёл, первым ушёл (LIFO),
+// как стопка тарелок. Поэтому `os.Remove` в `main` выполнится
+// после `closeFile`: файл сначала закрывается, потом удаляется.
+func deferOrder() {
+	defer fmt.Println("deferred 1")
+	defer fmt.Println("deferred 2")
+	defer fmt.Println("deferred 3")
+	fmt.Println("deferOrder body")
+}
@@ -0,0 +1,57 @@
+// Go позволяет _восстановиться_ после паники с помощью
+// встроенной функции `recover`. `recover` может остановить
+// аварийное завершение программы из-за `panic` и позволить
+// ей продолжить выполнение.
+//
+// Пример, где это полезно: сервер не должен падать, если
+// обработчик одного из клиентских соединений столкнулся
+// с критической ошибкой. Вместо этого сервер хотел бы закрыть
+// это соединение и продолжить обслуживать остальных клиентов.
+// Именно так по умолчанию поступает пакет `net/http` в Go.
+
+package main
+
+import "fmt"
+
+// Эта функция паникует.
+func mayPanic() {
+	panic("a problem")
+}
+
+// safeCall вызывает `mayPanic`, но перехватывает панику.
+func safeCall() {
+	// `recover` нужно вызывать внутри отложенной функции:
+	// во время паники выполняются только отложенные вызовы,
+	// а при вызове вне `defer` `recover` просто вернёт `nil`.
+	// Когда функция паникует, `defer` срабатывает, и вызов
+	// `recover` внутри него перехватывает панику.
+	defer func() {
+		if r := recover(); r != nil {
+			// Значение, возвращаемое `recover`, — это значение,
+			// переданное в `panic`.
+			fmt.Println("Recovered. Error:\n", r)
+		}
+	}()
+
+	mayPanic()
+
+	// Этот код не выполнится, потому что `mayPanic` паникует.
+	// Выполнение `safeCall` прекращается в точке паники и
+	// продолжается в отложенном замыкании.
+	fmt.Println("After mayPanic()")
+}
+
+func main() {
+	safeCall()
+
+	// Паника остановлена внутри `safeCall` и дальше не
+	// распространяется: `main` продолжает работать как обычно.
+	fmt.Println("After safeCall()")
+}
+
+// Пояснения:
+// recover только в defer: вне отложенной функции recover всегда возвращает nil и ничего не останавливает.
+
+// Остановка паники: после успешного recover функция, в которой сработал defer, завершается нормально, а вызвавший её код даже не узнаёт о панике.
+
+// Связь с ошибками: recover не замена обычной обработке ошибок из 26_errors.go. Его используют на границах — например, чтобы превратить неожиданную панику в обработчике запроса в ошибку или запись в лог, а не уронить весь сервер.
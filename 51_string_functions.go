@@ -0,0 +1,43 @@
+// Стандартный пакет `strings` предоставляет множество
+// полезных функций для работы со строками. Вот несколько
+// примеров, чтобы получить представление о пакете.
+
+package main
+
+import (
+	"fmt"
+	s "strings"
+)
+
+// Мы присваиваем `fmt.Println` короткой переменной, так как
+// будем часто её использовать ниже.
+var p = fmt.Println
+
+func main() {
+
+	// Вот пример функций из пакета `strings`. Поскольку это
+	// функции пакета, а не методы самого строкового объекта,
+	// строку нужно передавать первым аргументом. Больше функций
+	// можно найти в документации пакета [strings](https://pkg.go.dev/strings).
+	//
+	// Учтите, что эти функции работают с байтами строки. Для
+	// ASCII это незаметно, но, как мы видели в примере о
+	// [строках и рунах](18_strings_and_runes.go), индекс, который
+	// возвращает `Index`, — это смещение в байтах, а не номер символа.
+	p("Contains:  ", s.Contains("test", "es"))
+	p("Count:     ", s.Count("test", "t"))
+	p("HasPrefix: ", s.HasPrefix("test", "te"))
+	p("HasSuffix: ", s.HasSuffix("test", "st"))
+	p("Index:     ", s.Index("test", "e"))
+	p("Join:      ", s.Join([]string{"a", "b"}, "-"))
+	p("Repeat:    ", s.Repeat("a", 5))
+	p("Replace:   ", s.Replace("foo", "o", "0", -1))
+	p("Replace:   ", s.Replace("foo", "o", "0", 1))
+	p("Split:     ", s.Split("a-b-c-d-e", "-"))
+	p("ToLower:   ", s.ToLower("TEST"))
+	p("ToUpper:   ", s.ToUpper("test"))
+
+	// Байтовое смещение: "ж" занимает два байта, поэтому
+	// "и" в строке "жи" начинается с индекса 2, а не 1.
+	p("Index:     ", s.Index("жи", "и"))
+}
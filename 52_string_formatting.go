@@ -0,0 +1,110 @@
+// Go отлично поддерживает форматирование строк в традиции
+// `printf`. Вот несколько примеров распространённых задач
+// форматирования.
+
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+// Та же структура `point`, что и в примерах о структурах.
+type point struct {
+	x, y int
+}
+
+func main() {
+
+	// Go предлагает несколько "глаголов" вывода, предназначенных
+	// для форматирования обычных значений Go. Например, этот
+	// выводит экземпляр нашей структуры `point`.
+	p := point{1, 2}
+	fmt.Printf("struct1: %v\n", p)
+
+	// Если значение — структура, вариант `%+v` добавит
+	// имена полей.
+	fmt.Printf("struct2: %+v\n", p)
+
+	// Вариант `%#v` выводит представление значения в
+	// синтаксисе Go, т.е. фрагмент исходного кода, который
+	// создал бы это значение.
+	fmt.Printf("struct3: %#v\n", p)
+
+	// Чтобы вывести тип значения, используйте `%T`.
+	fmt.Printf("type: %T\n", p)
+
+	// Форматирование логических значений прямолинейно.
+	fmt.Printf("bool: %t\n", true)
+
+	// Для целых чисел есть много вариантов. Используйте `%d`
+	// для стандартного десятичного форматирования.
+	fmt.Printf("int: %d\n", 123)
+
+	// Этот выводит двоичное представление.
+	fmt.Printf("bin: %b\n", 14)
+
+	// Этот выводит символ, соответствующий данному целому числу.
+	fmt.Printf("char: %c\n", 33)
+
+	// `%x` даёт шестнадцатеричное представление.
+	fmt.Printf("hex: %x\n", 456)
+
+	// Для чисел с плавающей точкой тоже есть несколько вариантов.
+	// Для базового десятичного форматирования используйте `%f`.
+	fmt.Printf("float1: %f\n", 78.9)
+
+	// `%e` и `%E` форматируют число в (немного разных вариантах)
+	// экспоненциальной записи.
+	fmt.Printf("float2: %e\n", 123400000.0)
+	fmt.Printf("float3: %E\n", 123400000.0)
+
+	// Для простого вывода строк используйте `%s`.
+	fmt.Printf("str1: %s\n", "\"string\"")
+
+	// Чтобы заключить строку в двойные кавычки, как в
+	// исходном коде Go, используйте `%q`.
+	fmt.Printf("str2: %q\n", "\"string\"")
+
+	// Как и с целыми числами, `%x` выводит строку в
+	// шестнадцатеричном виде, по два символа на байт ввода.
+	fmt.Printf("str3: %x\n", "hex this")
+
+	// Чтобы вывести представление указателя, используйте `%p`.
+	// Адрес зависит от запуска, поэтому этот вывод не
+	// детерминирован.
+	fmt.Printf("pointer: %p\n", &p)
+
+	// При форматировании чисел часто нужно управлять шириной
+	// и точностью результата. Чтобы задать ширину целого числа,
+	// укажите число после `%`. По умолчанию результат
+	// выравнивается по правому краю и дополняется пробелами.
+	fmt.Printf("width1: |%6d|%6d|\n", 12, 345)
+
+	// Можно также задать ширину для чисел с плавающей точкой,
+	// а обычно заодно и точность с помощью синтаксиса
+	// `ширина.точность`.
+	fmt.Printf("width2: |%6.2f|%6.2f|\n", 1.2, 3.45)
+
+	// Для выравнивания по левому краю используйте флаг `-`.
+	fmt.Printf("width3: |%-6.2f|%-6.2f|\n", 1.2, 3.45)
+
+	// Ширину можно задавать и при форматировании строк,
+	// особенно для выравнивания в табличном выводе.
+	fmt.Printf("width4: |%6s|%6s|\n", "foo", "b")
+
+	// Для выравнивания по левому краю используйте флаг `-`,
+	// как и для чисел.
+	fmt.Printf("width5: |%-6s|%-6s|\n", "foo", "b")
+
+	// Пока мы видели `Printf`, который выводит отформатированную
+	// строку в `os.Stdout`. `Sprintf` форматирует строку и
+	// возвращает её, ничего не печатая.
+	s := fmt.Sprintf("sprintf: a %s", "string")
+	fmt.Println(s)
+
+	// Можно форматировать и выводить в другие `io.Writer`,
+	// а не только в `os.Stdout`, с помощью `Fprintf`. Например,
+	// так сообщения об ошибках пишут в стандартный поток ошибок.
+	fmt.Fprintf(os.Stderr, "io: an %s\n", "error")
+}
@@ -0,0 +1,95 @@
+// Go предлагает встроенную поддержку создания динамического
+// содержимого или вывода настроенного текста пользователю с
+// помощью пакета `text/template`. Родственный пакет
+// `html/template` предоставляет тот же API, но с дополнительными
+// функциями безопасности, и его следует использовать для
+// генерации HTML.
+
+package main
+
+import (
+	"os"
+	"text/template"
+)
+
+func main() {
+
+	// Мы можем создать новый шаблон и разобрать его тело из
+	// строки. Шаблоны — это смесь статического текста и
+	// "действий", заключённых в `{{...}}`, которые используются
+	// для динамической вставки содержимого.
+	//
+	// В отличие от `fmt`, где формат и аргументы задаются при
+	// каждом вызове, шаблон разбирается один раз, а затем
+	// применяется к разным данным; к тому же в нём есть условия
+	// и циклы.
+	t1 := template.New("t1")
+	t1, err := t1.Parse("Value is {{.}}\n")
+	if err != nil {
+		panic(err)
+	}
+
+	// Также можно использовать функцию `template.Must`, чтобы
+	// вызвать панику, если `Parse` вернёт ошибку. Это особенно
+	// полезно для шаблонов, инициализируемых в глобальной
+	// области видимости: ошибка в тексте шаблона — ошибка
+	// программиста, и лучше узнать о ней сразу при запуске.
+	t1 = template.Must(t1.Parse("Value: {{.}}\n"))
+
+	// "Выполняя" шаблон, мы генерируем его текст с конкретными
+	// значениями для его действий. Действие `{{.}}` заменяется
+	// значением, переданным в `Execute`.
+	t1.Execute(os.Stdout, "some text")
+	t1.Execute(os.Stdout, 5)
+	t1.Execute(os.Stdout, []string{
+		"Go",
+		"Rust",
+		"C++",
+		"C#",
+	})
+
+	// Вспомогательная функция, которую мы будем использовать ниже.
+	Create := func(name, t string) *template.Template {
+		return template.Must(template.New(name).Parse(t))
+	}
+
+	// Если данные — структура, мы можем использовать действие
+	// `{{.FieldName}}` для доступа к её полям. Поля должны быть
+	// экспортированы, чтобы быть доступными при выполнении шаблона.
+	t2 := Create("t2", "Name: {{.Name}}\n")
+
+	t2.Execute(os.Stdout, struct {
+		Name string
+	}{"Jane Doe"})
+
+	// То же самое относится к картам; для карт нет ограничений
+	// на регистр имён ключей.
+	t2.Execute(os.Stdout, map[string]string{
+		"Name": "Mickey Mouse",
+	})
+
+	// if/else обеспечивают условное выполнение для шаблонов.
+	// Значение считается ложным, если это нулевое значение
+	// типа, например 0, пустая строка, nil-указатель и т.д.
+	// Этот пример демонстрирует ещё одну особенность шаблонов:
+	// использование `-` в действиях для удаления пробельных
+	// символов рядом с ними: `{{if . -}}` убирает пробелы
+	// справа от действия, а `{{- end}}` — слева.
+	t3 := Create("t3",
+		"{{if . -}} yes {{- else -}} no {{- end}}\n")
+	t3.Execute(os.Stdout, "not empty")
+	t3.Execute(os.Stdout, "")
+
+	// Блоки range позволяют перебирать срезы, массивы, карты
+	// или каналы. Внутри блока range `{{.}}` устанавливается
+	// в текущий элемент итерации.
+	t4 := Create("t4",
+		"Range: {{range .}}{{.}} {{end}}\n")
+	t4.Execute(os.Stdout,
+		[]string{
+			"Go",
+			"Rust",
+			"C++",
+			"C#",
+		})
+}
@@ -0,0 +1,79 @@
+// Go предлагает встроенную поддержку
+// [регулярных выражений](https://en.wikipedia.org/wiki/Regular_expression).
+// Вот несколько примеров распространённых задач, связанных
+// с регулярными выражениями в Go.
+
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+func main() {
+
+	// Проверяет, соответствует ли шаблон строке.
+	match, _ := regexp.MatchString("p([a-z]+)ch", "peach")
+	fmt.Println(match)
+
+	// Выше мы использовали строковый шаблон напрямую, но для
+	// других задач с регулярными выражениями нужно
+	// скомпилировать (`Compile`) оптимизированную структуру `Regexp`.
+	// Компиляция — дорогая операция, поэтому выражение
+	// компилируют один раз и затем переиспользуют.
+	// `MustCompile` паникует вместо того, чтобы возвращать ошибку,
+	// что удобно для шаблонов, заданных в коде.
+	r := regexp.MustCompile("p([a-z]+)ch")
+
+	// Для этих структур доступно множество методов. Вот проверка
+	// на совпадение, как мы видели ранее.
+	fmt.Println(r.MatchString("peach"))
+
+	// Этот метод находит совпадение для регулярного выражения.
+	// Методы `Find` ищут _первое_ совпадение.
+	fmt.Println(r.FindString("peach punch pinch"))
+
+	// Этот метод тоже находит первое совпадение, но возвращает
+	// начальный и конечный индексы совпадения вместо самого текста.
+	fmt.Println("idx:", r.FindStringIndex("peach punch pinch"))
+
+	// Варианты `Submatch` включают информацию как о совпадении
+	// всего шаблона, так и о совпадениях подвыражений внутри
+	// него. Например, здесь будет возвращена информация
+	// как для `p([a-z]+)ch`, так и для `([a-z]+)`.
+	fmt.Println(r.FindStringSubmatch("peach punch pinch"))
+
+	// Аналогично, этот вернёт индексы совпадений и подсовпадений.
+	fmt.Println(r.FindStringSubmatchIndex("peach punch pinch"))
+
+	// Варианты `FindAll` применяются ко _всем_ совпадениям во
+	// входных данных, а не только к первому. Например, найти
+	// все совпадения для регулярного выражения.
+	fmt.Println(r.FindAllString("peach punch pinch", -1))
+
+	// Варианты `All` доступны и для других функций, которые мы
+	// видели выше.
+	fmt.Println("all:", r.FindAllStringSubmatchIndex(
+		"peach punch pinch", -1))
+
+	// Передав неотрицательное целое число вторым аргументом
+	// этим функциям, можно ограничить количество совпадений.
+	fmt.Println(r.FindAllString("peach punch pinch", 2))
+
+	// В примерах выше аргументами были строки, а методы
+	// назывались `MatchString` и т.п. Можно также передавать
+	// аргументы `[]byte` и убрать `String` из имени функции.
+	fmt.Println(r.Match([]byte("peach")))
+
+	// Метод `String` возвращает исходный шаблон.
+	fmt.Println("regexp:", r)
+
+	// Пакет `regexp` также можно использовать, чтобы заменять
+	// подмножества строк другими значениями.
+	fmt.Println(r.ReplaceAllString("a peach", "<fruit>"))
+
+	// Вариант `Func` позволяет преобразовать найденный текст
+	// с помощью заданной функции.
+	fmt.Println(r.ReplaceAllStringFunc("a peach", strings.ToUpper))
+}
@@ -0,0 +1,134 @@
+// Go предлагает встроенную поддержку кодирования и декодирования
+// JSON, в том числе во встроенные и пользовательские типы данных
+// и из них.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Мы будем использовать эти две структуры, чтобы показать
+// кодирование и декодирование пользовательских типов.
+//
+// Пакет `encoding/json` находит поля через рефлексию, а она
+// видит только _экспортированные_ поля — те, чьи имена начинаются
+// с заглавной буквы. Неэкспортированные поля при кодировании
+// и декодировании просто игнорируются.
+//
+// Теги структуры после типа поля задают имя ключа в JSON:
+// тег `json:"page"` переименовывает поле `Page` в привычный
+// для JSON ключ `page`.
+type response1 struct {
+	Page   int      `json:"page"`
+	Fruits []string `json:"fruits"`
+}
+
+// Без тегов ключ совпадает с именем поля (`Page`).
+type response2 struct {
+	Page   int
+	Fruits []string
+}
+
+func main() {
+
+	// Сначала рассмотрим кодирование базовых типов данных
+	// в строки JSON. Вот несколько примеров для атомарных значений.
+	bolB, _ := json.Marshal(true)
+	fmt.Println(string(bolB))
+
+	intB, _ := json.Marshal(1)
+	fmt.Println(string(intB))
+
+	fltB, _ := json.Marshal(2.34)
+	fmt.Println(string(fltB))
+
+	strB, _ := json.Marshal("gopher")
+	fmt.Println(string(strB))
+
+	// А вот примеры для срезов и карт, которые кодируются
+	// в массивы и объекты JSON, как и ожидается.
+	slcD := []string{"apple", "peach", "pear"}
+	slcB, _ := json.Marshal(slcD)
+	fmt.Println(string(slcB))
+
+	mapD := map[string]int{"apple": 5, "lettuce": 7}
+	mapB, _ := json.Marshal(mapD)
+	fmt.Println(string(mapB))
+
+	// Пакет JSON может автоматически кодировать ваши
+	// пользовательские типы данных. В выводе будут только
+	// экспортированные поля, а ключи берутся из тегов.
+	res1D := &response1{
+		Page:   1,
+		Fruits: []string{"apple", "peach", "pear"}}
+	res1B, _ := json.Marshal(res1D)
+	fmt.Println(string(res1B))
+
+	// Без тегов ключами становятся имена полей.
+	res2D := &response2{
+		Page:   1,
+		Fruits: []string{"apple", "peach", "pear"}}
+	res2B, _ := json.Marshal(res2D)
+	fmt.Println(string(res2B))
+
+	// Теперь рассмотрим декодирование данных JSON в значения
+	// Go. Вот пример для обобщённой структуры данных.
+	byt := []byte(`{"num":6.13,"strs":["a","b"]}`)
+
+	// Нам нужно предоставить переменную, в которую пакет JSON
+	// сможет поместить декодированные данные. Эта
+	// `map[string]interface{}` будет содержать карту строк
+	// в произвольные типы данных — так обрабатывают JSON,
+	// форма которого заранее неизвестна.
+	var dat map[string]interface{}
+
+	// Вот само декодирование и проверка на ошибки.
+	if err := json.Unmarshal(byt, &dat); err != nil {
+		panic(err)
+	}
+	fmt.Println(dat)
+
+	// Чтобы использовать значения из декодированной карты, нужно
+	// привести их к подходящему типу. Например, здесь мы
+	// приводим значение `num` к ожидаемому типу `float64`:
+	// все числа JSON декодируются в `float64`.
+	num := dat["num"].(float64)
+	fmt.Println(num)
+
+	// Доступ к вложенным данным требует серии приведений.
+	// Форма приведения с двумя значениями не паникует, если
+	// тип оказался другим, и позволяет обработать неожиданные данные.
+	strs, ok := dat["strs"].([]interface{})
+	if ok {
+		str1 := strs[0].(string)
+		fmt.Println(str1)
+	}
+
+	// Мы также можем декодировать JSON в пользовательские
+	// типы данных. Это даёт дополнительную типобезопасность
+	// и избавляет от приведений типов при доступе к данным.
+	str := `{"page": 1, "fruits": ["apple", "peach"]}`
+	res := response1{}
+	json.Unmarshal([]byte(str), &res)
+	fmt.Println(res)
+	fmt.Println(res.Fruits[0])
+
+	// В примерах выше мы всегда использовали байты и строки
+	// как промежуточное звено между данными и JSON. Можно также
+	// передавать JSON-кодировку напрямую в `io.Writer`, например
+	// `os.Stdout` или даже тела HTTP-ответов.
+	enc := json.NewEncoder(os.Stdout)
+	d := map[string]int{"apple": 5, "lettuce": 7}
+	enc.Encode(d)
+
+	// Потоковое чтение из `io.Reader`, например `os.Stdin` или
+	// тел HTTP-запросов, выполняется с помощью `json.Decoder`.
+	dec := json.NewDecoder(strings.NewReader(str))
+	res3 := response1{}
+	dec.Decode(&res3)
+	fmt.Println(res3)
+}
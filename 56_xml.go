@@ -0,0 +1,70 @@
+// Go предлагает встроенную поддержку XML и XML-подобных
+// форматов с помощью пакета `encoding/xml`. API устроен так же,
+// как в [примере с JSON](55_json.go): `Marshal`/`Unmarshal`
+// и теги структур, только теги называются `xml`.
+
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+// `Plant` будет отображаться в XML. Как и в JSON, теги полей
+// содержат указания для кодировщика и декодировщика.
+// Особое поле `XMLName` задаёт имя XML-элемента, который
+// представляет эту структуру; `id,attr` означает, что поле
+// `Id` будет XML-_атрибутом_, а не вложенным элементом.
+type Plant struct {
+	XMLName xml.Name `xml:"plant"`
+	Id      int      `xml:"id,attr"`
+	Name    string   `xml:"name"`
+	Origin  []string `xml:"origin"`
+}
+
+func (p Plant) String() string {
+	return fmt.Sprintf("Plant id=%v, name=%v, origin=%v",
+		p.Id, p.Name, p.Origin)
+}
+
+func main() {
+	coffee := &Plant{Id: 27, Name: "Coffee"}
+	coffee.Origin = []string{"Ethiopia", "Brazil"}
+
+	// Сгенерируем XML, представляющий наше растение;
+	// `MarshalIndent` выдаёт более читаемый вывод с отступами.
+	// Каждый элемент среза `Origin` становится отдельным
+	// элементом `<origin>`.
+	out, _ := xml.MarshalIndent(coffee, " ", "  ")
+	fmt.Println(string(out))
+
+	// Чтобы добавить стандартный XML-заголовок `<?xml ...?>`,
+	// допишем его в начало явно: `Marshal` его не выводит.
+	fmt.Println(xml.Header + string(out))
+
+	// `Unmarshal` разбирает поток байтов с XML в структуру.
+	// Если XML некорректен или не отображается на `Plant`,
+	// вернётся ошибка с описанием.
+	var p Plant
+	if err := xml.Unmarshal(out, &p); err != nil {
+		panic(err)
+	}
+	fmt.Println(p)
+
+	tomato := &Plant{Id: 81, Name: "Tomato"}
+	tomato.Origin = []string{"Mexico", "California"}
+
+	// Тег `parent>child>plant` говорит кодировщику вложить
+	// все `plant` в `<parent><child>...`, не заводя для
+	// промежуточных элементов отдельных типов.
+	type Nesting struct {
+		XMLName xml.Name `xml:"nesting"`
+		Plants  []*Plant `xml:"parent>child>plant"`
+	}
+
+	nesting := &Nesting{}
+	nesting.Plants = []*Plant{coffee, tomato}
+
+	out, _ = xml.MarshalIndent(nesting, " ", "  ")
+	fmt.Println(string(out))
+}
@@ -0,0 +1,71 @@
+// Go предлагает обширную поддержку времени и длительностей;
+// вот несколько примеров.
+
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+func main() {
+	p := fmt.Println
+
+	// Начнём с получения текущего времени. Оно меняется при
+	// каждом запуске, поэтому дальше мы работаем с
+	// фиксированными датами.
+	now := time.Now()
+	p(now)
+
+	// Можно создать структуру `time`, указав год, месяц, день
+	// и т.д. Время всегда связано с часовым поясом (`Location`).
+	// `time.UTC` — всемирное координированное время: в отличие
+	// от `time.Local`, оно не зависит от настроек машины, поэтому
+	// результаты ниже одинаковы везде.
+	then := time.Date(
+		2009, 11, 17, 20, 34, 58, 651387237, time.UTC)
+	p(then)
+
+	// Можно извлекать различные компоненты значения времени,
+	// как и ожидается.
+	p(then.Year())
+	p(then.Month())
+	p(then.Day())
+	p(then.Hour())
+	p(then.Minute())
+	p(then.Second())
+	p(then.Nanosecond())
+	p(then.Location())
+
+	// Также доступен день недели (`Weekday`) с понедельника
+	// по воскресенье.
+	p(then.Weekday())
+
+	// Эти методы сравнивают два момента времени, проверяя,
+	// происходит ли первый раньше, позже или одновременно
+	// со вторым. `Equal` сравнивает моменты, а не записи:
+	// одно и то же время в разных поясах равно.
+	later := time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC)
+	p(then.Before(later))
+	p(then.After(later))
+	p(then.Equal(later))
+	p(then.Equal(then.In(time.FixedZone("MSK", 3*60*60))))
+
+	// Метод `Sub` возвращает `Duration` — интервал между двумя
+	// моментами. `time.Duration` — это просто `int64` с числом
+	// наносекунд, поэтому длительности можно складывать,
+	// умножать и сравнивать как обычные числа.
+	diff := later.Sub(then)
+	p(diff)
+
+	// Длительность можно выразить в разных единицах.
+	p(diff.Hours())
+	p(diff.Minutes())
+	p(diff.Seconds())
+	p(diff.Nanoseconds())
+
+	// `Add` сдвигает время на заданную длительность, а с
+	// минусом — назад.
+	p(then.Add(diff))
+	p(then.Add(-diff))
+}
@@ -0,0 +1,50 @@
+// Частая задача в программах — получить количество секунд,
+// миллисекунд или наносекунд, прошедших с
+// [эпохи Unix](https://en.wikipedia.org/wiki/Unix_time).
+// Вот как это сделать в Go.
+
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+func main() {
+
+	// Эпоха Unix — момент 1 января 1970 года, 00:00:00 UTC.
+	// Время, отсчитанное от неё, — просто число, не зависящее
+	// от часового пояса, поэтому его удобно хранить и передавать.
+	// Используйте `time.Now` с `Unix`, `UnixMilli` или `UnixNano`,
+	// чтобы получить время с начала эпохи в секундах,
+	// миллисекундах или наносекундах соответственно.
+	now := time.Now()
+	fmt.Println(now)
+
+	fmt.Println(now.Unix())
+	fmt.Println(now.UnixMilli())
+	fmt.Println(now.UnixNano())
+
+	// Результат `Now` меняется при каждом запуске, поэтому
+	// остальное покажем на фиксированном моменте.
+	t := time.Unix(1700000000, 0).UTC()
+	fmt.Println(t)
+
+	// Единицы отличаются в 1000 раз: в секунде 1000 миллисекунд,
+	// а в миллисекунде 1 000 000 наносекунд. `UnixNano` помещается
+	// в `int64` только для дат примерно с 1678 по 2262 год.
+	secs := t.Unix()
+	millis := t.UnixMilli()
+	nanos := t.UnixNano()
+	fmt.Println(secs, millis, nanos)
+	fmt.Println(millis == secs*1000, nanos == millis*1_000_000)
+
+	// Можно также преобразовать целые секунды или наносекунды
+	// с начала эпохи обратно в соответствующее значение `time`.
+	// `time.Unix` принимает секунды и наносекунды сверх них,
+	// а `time.UnixMilli` — миллисекунды.
+	fmt.Println(time.Unix(secs, 0).UTC())
+	fmt.Println(time.Unix(0, nanos).UTC())
+	fmt.Println(time.UnixMilli(millis).UTC())
+	fmt.Println(time.Unix(0, nanos).Equal(t))
+}
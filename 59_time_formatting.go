@@ -0,0 +1,72 @@
+// Go поддерживает форматирование и разбор времени с помощью
+// шаблонов, основанных на образце.
+
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+func main() {
+	p := fmt.Println
+
+	// Вместо `time.Now` берём фиксированный момент, чтобы вывод
+	// не менялся от запуска к запуску.
+	t := time.Date(2024, 3, 9, 14, 5, 7, 123456789, time.UTC)
+
+	// Вот простой пример форматирования времени по RFC3339
+	// с помощью соответствующей константы шаблона.
+	p(t.Format(time.RFC3339))
+
+	// Разбор времени использует те же значения шаблонов,
+	// что и `Format`.
+	t1, err := time.Parse(
+		time.RFC3339,
+		"2012-11-01T22:08:41+00:00")
+	p(t1, err)
+
+	// Шаблоны в Go — не `%Y-%m-%d`, а пример того, как должно
+	// выглядеть одно определённое _опорное_ время:
+	// `Mon Jan 2 15:04:05 MST 2006`, или в числовом виде
+	// `01/02 03:04:05PM '06 -0700` — месяц 1, день 2, час 3,
+	// минута 4, секунда 5, год 6, пояс -7. Чтобы задать формат,
+	// запишите это время так, как хотите видеть любое другое.
+	p(t.Format("3:04PM"))
+	p(t.Format("Mon Jan _2 15:04:05 2006"))
+	p(t.Format("2006-01-02T15:04:05.999999-07:00"))
+
+	// Частая ошибка новичков — написать в шаблоне настоящую дату,
+	// например `2024-03-09` вместо `2006-01-02`. Такие цифры не
+	// выводятся как есть: `Format` молча читает их как другие
+	// поля шаблона. Здесь `2` — день, `0` не поле, `2` — снова
+	// день, `4` — минута, `03` — час в 12-часовом формате, `09` —
+	// просто цифры, и для нашего `t` получается `9095-02-09`.
+	p(t.Format("2024-03-09"))
+	form := "3 04 PM"
+	t2, err := time.Parse(form, "8 41 PM")
+	p(t2, err)
+
+	// Шаблон и разбор — взаимно обратные операции: строка,
+	// полученная через `Format`, разбирается тем же шаблоном
+	// обратно в то же время.
+	layout := "Mon Jan _2 15:04:05 2006"
+	s := t.Format(layout)
+	back, err := time.Parse(layout, s)
+	p(back, err)
+	p(back.Equal(t.Truncate(time.Second)))
+
+	// Для чисто числовых представлений можно также использовать
+	// стандартное форматирование строк с извлечёнными
+	// компонентами значения времени.
+	fmt.Printf("%d-%02d-%02dT%02d:%02d:%02d-00:00\n",
+		t.Year(), t.Month(), t.Day(),
+		t.Hour(), t.Minute(), t.Second())
+
+	// `Parse` возвращает ошибку для некорректного ввода, как и
+	// другие функции из [примера про ошибки](26_errors.go);
+	// её текст объясняет, что не совпало.
+	ansic := "Mon Jan _2 15:04:05 2006"
+	_, e := time.Parse(ansic, "8:41PM")
+	p(e)
+}
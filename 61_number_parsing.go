@@ -0,0 +1,54 @@
+// Разбор чисел из строк — простая, но распространённая задача
+// во многих программах; вот как это сделать в Go.
+
+package main
+
+// Встроенный пакет `strconv` обеспечивает разбор чисел.
+import (
+	"fmt"
+	"strconv"
+)
+
+func main() {
+
+	// В `ParseFloat` аргумент `64` указывает, сколько бит
+	// точности нужно: результат помещается в `float64`.
+	f, _ := strconv.ParseFloat("1.234", 64)
+	fmt.Println(f)
+
+	// Для `ParseInt` аргумент `0` означает, что основание
+	// системы счисления определяется по префиксу строки
+	// (`0x` — 16, `0o` или `0` — 8, `0b` — 2, иначе 10).
+	// Последний аргумент — `bitSize`: `64` требует, чтобы
+	// результат помещался в 64 бита; для `8` число больше 127
+	// вернуло бы ошибку выхода за диапазон.
+	i, _ := strconv.ParseInt("123", 0, 64)
+	fmt.Println(i)
+
+	// `ParseInt` распознаёт числа в шестнадцатеричном формате.
+	d, _ := strconv.ParseInt("0x1c8", 0, 64)
+	fmt.Println(d)
+
+	// Основание можно задать и явно — тогда префикс не нужен.
+	b, _ := strconv.ParseInt("1010", 2, 64)
+	fmt.Println(b)
+
+	// Также доступна функция `ParseUint` для беззнаковых чисел.
+	u, _ := strconv.ParseUint("789", 0, 64)
+	fmt.Println(u)
+
+	// `Atoi` — удобная функция для простого разбора
+	// десятичных `int`; это `ParseInt(s, 10, 0)`.
+	k, _ := strconv.Atoi("135")
+	fmt.Println(k)
+
+	// Функции разбора возвращают ошибку при некорректном вводе.
+	// Выше мы её отбрасывали для краткости, но в настоящем коде
+	// её нужно проверять, как в [примере про ошибки](26_errors.go).
+	_, e := strconv.Atoi("wat")
+	fmt.Println(e)
+
+	// Выход за пределы `bitSize` — тоже ошибка.
+	_, e = strconv.ParseInt("300", 10, 8)
+	fmt.Println(e)
+}
@@ -0,0 +1,53 @@
+// [Хеши SHA256](https://en.wikipedia.org/wiki/SHA-2) часто
+// используются для вычисления коротких идентификаторов двоичных
+// или текстовых данных. Например, TLS/SSL-сертификаты используют
+// SHA256 для вычисления подписи сертификата. Вот как вычислять
+// хеши SHA256 в Go.
+
+package main
+
+// Go реализует несколько хеш-функций в различных
+// пакетах `crypto/*`.
+import (
+	"crypto/sha256"
+	"fmt"
+)
+
+func main() {
+	s := "sha256 this string"
+
+	// Если данные целиком уже в памяти и их немного, проще всего
+	// вызвать `Sum256`: она возвращает массив `[32]byte`, а не
+	// срез, поэтому его можно сравнивать через `==` и
+	// использовать как ключ карты.
+	sum := sha256.Sum256([]byte(s))
+	fmt.Printf("%x\n", sum)
+
+	// Для больших или поступающих частями данных (файлов, сетевых
+	// потоков) создайте хеш с помощью `sha256.New` и пишите в
+	// него по кускам: `Write` ожидает байты. Хеш реализует
+	// `io.Writer`, так что в него можно копировать через `io.Copy`.
+	h := sha256.New()
+	h.Write([]byte("sha256 "))
+	h.Write([]byte("this string"))
+
+	// `Sum` получает итоговый результат хеширования в виде
+	// байтового среза. Аргумент позволяет дописать результат
+	// к существующему срезу; обычно он не нужен, и передают `nil`.
+	bs := h.Sum(nil)
+
+	// Оба способа дают один и тот же хеш.
+	fmt.Printf("%x\n", bs)
+	fmt.Println(string(bs) == string(sum[:]))
+
+	// Даже крошечное изменение входа меняет хеш до
+	// неузнаваемости.
+	fmt.Printf("%x\n", sha256.Sum256([]byte("sha256 this string!")))
+}
+
+// Пояснения:
+// SHA256 подходит для проверки целостности данных: совпадение
+// хешей означает, что содержимое не изменилось. Для хранения
+// паролей он не годится — он слишком быстрый, и пароли легко
+// подобрать перебором. Для них используйте медленные функции вроде
+// bcrypt, scrypt или argon2 (пакеты в golang.org/x/crypto).
@@ -0,0 +1,59 @@
+// Go предоставляет встроенную поддержку
+// [кодирования/декодирования base64](https://en.wikipedia.org/wiki/Base64).
+
+package main
+
+// Этот синтаксис импортирует пакет `encoding/base64` с
+// именем `b64` вместо стандартного `base64`. Это сэкономит
+// нам немного места ниже.
+import (
+	b64 "encoding/base64"
+	"fmt"
+)
+
+func main() {
+
+	// Вот строка, которую мы будем кодировать и декодировать.
+	// Символы `<<???>>` выбраны не случайно: их байты
+	// кодируются в `+` и `/`, которыми стандартный и
+	// URL-безопасный алфавиты как раз отличаются.
+	data := "abc123!?$*&()'-=@~<<???>>"
+
+	// Go поддерживает как стандартный, так и URL-совместимый
+	// base64. Вот как кодировать с использованием стандартного
+	// кодировщика. Кодировщику нужен `[]byte`, поэтому мы
+	// преобразуем нашу строку в этот тип.
+	//
+	// Base64 кодирует каждые 3 байта в 4 символа. Если длина
+	// данных не кратна трём, результат дополняется символами
+	// `=` (padding) до длины, кратной четырём.
+	sEnc := b64.StdEncoding.EncodeToString([]byte(data))
+	fmt.Println(sEnc)
+
+	// Декодирование может вернуть ошибку, которую можно
+	// проверить, если вы не уверены, что входные данные
+	// корректны.
+	sDec, err := b64.StdEncoding.DecodeString(sEnc)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(string(sDec))
+	fmt.Println(string(sDec) == data)
+
+	// В стандартном алфавите есть `+` и `/`, которые в URL и
+	// именах файлов имеют особый смысл. URL-совместимый формат
+	// заменяет их на `-` и `_`; остальное совпадает.
+	uEnc := b64.URLEncoding.EncodeToString([]byte(data))
+	fmt.Println(uEnc)
+	uDec, err := b64.URLEncoding.DecodeString(uEnc)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(string(uDec))
+	fmt.Println(string(uDec) == data)
+
+	// Алфавиты не взаимозаменяемы: стандартный декодер не
+	// понимает `-` и `_` и возвращает ошибку.
+	_, err = b64.StdEncoding.DecodeString(uEnc)
+	fmt.Println(err)
+}
@@ -0,0 +1,109 @@
+// Чтение и запись файлов — базовые задачи, необходимые для
+// многих программ Go. Сначала рассмотрим несколько примеров
+// чтения файлов.
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// Чтение файлов требует проверки большинства вызовов на
+// ошибки. Этот помощник упростит наши проверки ошибок ниже:
+// как в [примере про панику](48_panic.go), неожиданная ошибка
+// просто прерывает программу.
+func check(e error) {
+	if e != nil {
+		panic(e)
+	}
+}
+
+func main() {
+
+	// Чтобы пример не зависел от окружения, сначала создадим
+	// файл во временной директории. Отложенный `os.RemoveAll`
+	// удалит её по завершении.
+	dir, err := os.MkdirTemp("", "reading")
+	check(err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "dat")
+	err = os.WriteFile(path, []byte("hello\ngo\n"), 0644)
+	check(err)
+
+	// Возможно, самая базовая задача чтения файла —
+	// загрузка всего его содержимого в память.
+	dat, err := os.ReadFile(path)
+	check(err)
+	fmt.Print(string(dat))
+
+	// Часто нужен больший контроль над тем, как и какие части
+	// файла читаются. Для этих задач начните с открытия
+	// файла с помощью `os.Open`, чтобы получить значение `os.File`.
+	// Закрытие откладываем через `defer` сразу после проверки
+	// ошибки: так файл закроется, как бы ни завершилась функция.
+	f, err := os.Open(path)
+	check(err)
+	defer f.Close()
+
+	// Прочитаем несколько байтов из начала файла.
+	// Разрешаем прочитать до 5 байтов, но также отмечаем,
+	// сколько было прочитано на самом деле.
+	b1 := make([]byte, 5)
+	n1, err := f.Read(b1)
+	check(err)
+	fmt.Printf("%d bytes: %s\n", n1, string(b1[:n1]))
+
+	// Также можно перейти (`Seek`) к известной позиции в файле
+	// и прочитать (`Read`) оттуда.
+	o2, err := f.Seek(6, io.SeekStart)
+	check(err)
+	b2 := make([]byte, 2)
+	n2, err := f.Read(b2)
+	check(err)
+	fmt.Printf("%d bytes @ %d: ", n2, o2)
+	fmt.Printf("%v\n", string(b2[:n2]))
+
+	// Другие способы поиска позиции относительны текущей
+	// позиции курсора и конца файла.
+	_, err = f.Seek(2, io.SeekCurrent)
+	check(err)
+
+	_, err = f.Seek(-4, io.SeekEnd)
+	check(err)
+
+	// Пакет `io` предоставляет некоторые функции, которые могут
+	// быть полезны для чтения файлов. Например, чтения, подобные
+	// приведённым выше, можно реализовать более надёжно с помощью
+	// `ReadAtLeast`.
+	o3, err := f.Seek(6, io.SeekStart)
+	check(err)
+	b3 := make([]byte, 2)
+	n3, err := io.ReadAtLeast(f, b3, 2)
+	check(err)
+	fmt.Printf("%d bytes @ %d: %s\n", n3, o3, string(b3))
+
+	// Встроенной перемотки нет, но `Seek(0, io.SeekStart)`
+	// делает то же самое.
+	_, err = f.Seek(0, io.SeekStart)
+	check(err)
+
+	// Каждый `f.Read` — это системный вызов, и читать файл
+	// маленькими кусками без буфера дорого. Пакет `bufio`
+	// реализует буферизованный читатель: он читает файл крупными
+	// блоками в память, а мелкие чтения обслуживает из неё. Кроме
+	// того, у него есть удобные методы вроде `Peek` и `ReadString`.
+	r4 := bufio.NewReader(f)
+	b4, err := r4.Peek(5)
+	check(err)
+	fmt.Printf("5 bytes: %s\n", string(b4))
+
+	// `ReadString` читает до разделителя включительно.
+	line, err := r4.ReadString('\n')
+	check(err)
+	fmt.Printf("line: %q\n", line)
+}
@@ -0,0 +1,80 @@
+// Запись файлов в Go следует тем же шаблонам, что мы видели
+// ранее для [чтения](65_reading_files.go).
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Тот же помощник, что и в примере про чтение файлов.
+func check(e error) {
+	if e != nil {
+		panic(e)
+	}
+}
+
+func main() {
+
+	// Пишем во временную директорию, которую удалим в конце.
+	dir, err := os.MkdirTemp("", "writing")
+	check(err)
+	defer os.RemoveAll(dir)
+
+	// Для начала вот как записать строку (или просто байты)
+	// в файл. Третий аргумент — права доступа для нового файла.
+	d1 := []byte("hello\ngo\n")
+	path1 := filepath.Join(dir, "dat1")
+	err = os.WriteFile(path1, d1, 0644)
+	check(err)
+
+	// Для более детальной записи откройте файл для записи.
+	path2 := filepath.Join(dir, "dat2")
+	f, err := os.Create(path2)
+	check(err)
+
+	// Идиоматично откладывать `Close` сразу после
+	// открытия файла.
+	defer f.Close()
+
+	// Можно записывать (`Write`) срезы байтов, как и ожидается.
+	d2 := []byte{115, 111, 109, 101, 10}
+	n2, err := f.Write(d2)
+	check(err)
+	fmt.Printf("wrote %d bytes\n", n2)
+
+	// Также доступен `WriteString`.
+	n3, err := f.WriteString("writes\n")
+	check(err)
+	fmt.Printf("wrote %d bytes\n", n3)
+
+	// После `Write` данные лежат в кеше операционной системы и
+	// при сбое питания могут пропасть. `Sync` просит ОС
+	// сбросить их на диск — это нужно, когда важна надёжность.
+	check(f.Sync())
+
+	// `bufio` предоставляет буферизованные писатели в дополнение
+	// к буферизованным читателям, которые мы видели ранее.
+	// Мелкие записи копятся в памяти и уходят в файл крупными
+	// блоками.
+	w := bufio.NewWriter(f)
+	n4, err := w.WriteString("buffered\n")
+	check(err)
+	fmt.Printf("wrote %d bytes\n", n4)
+
+	// Пока буфер не заполнен, данные есть только в памяти
+	// программы. Используйте `Flush`, чтобы убедиться, что все
+	// буферизованные операции применены к файлу; забытый `Flush`
+	// — частая причина «потерянного» хвоста файла.
+	check(w.Flush())
+
+	// Прочитаем оба файла обратно, чтобы проверить результат.
+	for _, p := range []string{path1, path2} {
+		dat, err := os.ReadFile(p)
+		check(err)
+		fmt.Printf("%s:\n%s", filepath.Base(p), dat)
+	}
+}
@@ -0,0 +1,71 @@
+// _Строковый фильтр_ — распространённый тип программы, которая
+// читает ввод из stdin, обрабатывает его и затем выводит
+// некоторый производный результат в stdout. `grep` и `sed` —
+// распространённые строковые фильтры.
+
+// Вот пример строкового фильтра на Go, который выводит версию
+// всего входного текста в верхнем регистре. Можно использовать
+// этот шаблон для написания собственных строковых фильтров Go:
+//
+//	$ echo 'hello filter' | go run 67_line_filters.go
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// upperLines копирует строки из `r` в `w`, переводя их в
+// верхний регистр. Фильтр принимает `io.Reader` и `io.Writer`,
+// а не `os.Stdin` и `os.Stdout` напрямую, поэтому его можно
+// применить к любому источнику — например, к строке.
+func upperLines(r io.Reader, w io.Writer) error {
+
+	// Обёртка небуферизованного `r` в буферизованный сканер даёт
+	// удобный метод `Scan`, который продвигает сканер к следующей
+	// строке, и читает ввод крупными блоками, а не по байту.
+	scanner := bufio.NewScanner(r)
+
+	// Вывод тоже буферизуем: без буфера каждая строка была бы
+	// отдельным системным вызовом записи.
+	out := bufio.NewWriter(w)
+
+	// `Scan` возвращает `false` в конце ввода или при ошибке.
+	// `Text` возвращает текущую строку без символа перевода строки.
+	for scanner.Scan() {
+		ucl := strings.ToUpper(scanner.Text())
+
+		// Записываем строку в верхнем регистре.
+		fmt.Fprintln(out, ucl)
+	}
+
+	// Буфер нужно сбросить, иначе хвост вывода может потеряться.
+	if err := out.Flush(); err != nil {
+		return err
+	}
+
+	// После цикла проверяем ошибки `Scan`: конец файла ошибкой
+	// не считается, и `Err` в этом случае вернёт `nil`.
+	return scanner.Err()
+}
+
+func main() {
+
+	// Сначала применим фильтр к строке, чтобы вывод был
+	// предсказуемым при любом stdin.
+	demo := strings.NewReader("hello filter\nsecond line\n")
+	if err := upperLines(demo, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+
+	// А теперь — настоящий фильтр от stdin к stdout.
+	if err := upperLines(os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
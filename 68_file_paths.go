@@ -0,0 +1,74 @@
+// Пакет `filepath` предоставляет функции для разбора и
+// построения _путей к файлам_ способом, переносимым между
+// операционными системами; например, `dir/file` в Linux
+// и `dir\file` в Windows.
+
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+
+	// `Join` следует использовать для переносимого построения
+	// путей. Он принимает любое количество аргументов и строит
+	// из них иерархический путь. Ручная склейка через `"/"`
+	// сломается в Windows, где разделитель `\`, и легко даёт
+	// лишние или пропущенные разделители.
+	p := filepath.Join("dir1", "dir2", "filename")
+	fmt.Println("p:", p)
+
+	// Всегда используйте `Join` вместо ручной конкатенации `/`
+	// или `\`. Помимо переносимости, `Join` также нормализует
+	// пути, удаляя лишние разделители и изменения директорий.
+	fmt.Println(filepath.Join("dir1//", "filename"))
+	fmt.Println(filepath.Join("dir1/../dir1", "filename"))
+
+	// `Dir` и `Base` можно использовать, чтобы разделить путь
+	// на директорию и файл. Также `Split` вернёт обе части
+	// за один вызов.
+	fmt.Println("Dir(p):", filepath.Dir(p))
+	fmt.Println("Base(p):", filepath.Base(p))
+	fmt.Println(filepath.Split(p))
+
+	// Можно проверить, является ли путь абсолютным. Это тоже
+	// зависит от ОС: в Windows `/dir/file` не абсолютный,
+	// там нужна буква диска.
+	fmt.Println(filepath.IsAbs("dir/file"))
+	fmt.Println(filepath.IsAbs("/dir/file"))
+
+	filename := "config.json"
+
+	// У некоторых имён файлов есть расширения после точки.
+	// Можно отделить расширение от таких имён с помощью `Ext`.
+	ext := filepath.Ext(filename)
+	fmt.Println(ext)
+
+	// Чтобы найти имя файла без расширения,
+	// используйте `strings.TrimSuffix`.
+	fmt.Println(strings.TrimSuffix(filename, ext))
+
+	// `Rel` находит относительный путь между _базой_ и _целью_.
+	// Он возвращает ошибку, если цель нельзя сделать
+	// относительной к базе.
+	rel, err := filepath.Rel("a/b", "a/b/t/file")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(rel)
+
+	rel, err = filepath.Rel("a/b", "a/c/t/file")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(rel)
+}
+
+// Пояснения:
+// Вывод выше получен в Linux или macOS. В Windows разделителем
+// будет `\`: `dir1\dir2\filename`, `..\c\t\file` и т.д.
+// Если нужны пути с `/` независимо от ОС (например, в URL),
+// используйте пакет `path` вместо `path/filepath`.
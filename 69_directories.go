@@ -0,0 +1,92 @@
+// Go предоставляет несколько полезных функций для работы
+// с *директориями* в файловой системе.
+
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+func check(e error) {
+	if e != nil {
+		panic(e)
+	}
+}
+
+func main() {
+
+	// Всё строим внутри временной директории, чтобы пример
+	// не зависел от окружения и не оставлял мусора.
+	// `os.RemoveAll` удаляет всё дерево директорий, подобно
+	// `rm -rf`, поэтому уборку достаточно отложить один раз.
+	root, err := os.MkdirTemp("", "dirs")
+	check(err)
+	defer os.RemoveAll(root)
+
+	// Создаём новую поддиректорию. `Mkdir` создаёт ровно одну
+	// директорию и вернёт ошибку, если её родителя нет.
+	subdir := filepath.Join(root, "subdir")
+	err = os.Mkdir(subdir, 0755)
+	check(err)
+
+	// Вспомогательная функция для создания нового пустого файла.
+	createEmptyFile := func(name string) {
+		d := []byte("")
+		check(os.WriteFile(name, d, 0644))
+	}
+
+	createEmptyFile(filepath.Join(subdir, "file1"))
+
+	// Можно создать иерархию директорий, включая родительские,
+	// с помощью `MkdirAll`. Это похоже на команду `mkdir -p`.
+	err = os.MkdirAll(filepath.Join(subdir, "parent", "child"), 0755)
+	check(err)
+
+	createEmptyFile(filepath.Join(subdir, "parent", "file2"))
+	createEmptyFile(filepath.Join(subdir, "parent", "file3"))
+	createEmptyFile(filepath.Join(subdir, "parent", "child", "file4"))
+
+	// `ReadDir` перечисляет содержимое директории, возвращая
+	// срез `os.DirEntry`, отсортированный по имени. Запись
+	// знает своё имя и умеет сказать, директория ли это
+	// (`IsDir`), не делая дополнительного системного вызова.
+	c, err := os.ReadDir(filepath.Join(subdir, "parent"))
+	check(err)
+
+	fmt.Println("Listing subdir/parent")
+	for _, entry := range c {
+		fmt.Println(" ", entry.Name(), entry.IsDir())
+	}
+
+	// Также можно обойти директорию _рекурсивно_, включая все
+	// её поддиректории. `WalkDir` принимает функцию обратного
+	// вызова для обработки каждого файла или директории.
+	fmt.Println("Visiting subdir")
+	err = filepath.WalkDir(subdir, visit(subdir))
+	check(err)
+}
+
+// `visit` возвращает функцию, которую `filepath.WalkDir` вызывает
+// для каждого найденного файла или директории. Она получает путь,
+// запись `fs.DirEntry` и ошибку: если прочитать запись не удалось,
+// `err` не `nil`. Возврат ошибки из обратного вызова прерывает
+// обход, и `WalkDir` возвращает её вызывающему коду; возврат
+// `fs.SkipDir` для директории пропускает только её содержимое.
+// Пути печатаем относительно `base`, чтобы вывод не зависел от
+// имени временной директории.
+func visit(base string) fs.WalkDirFunc {
+	return func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(base, path)
+		if err != nil {
+			return err
+		}
+		fmt.Println(" ", filepath.ToSlash(rel), d.IsDir())
+		return nil
+	}
+}
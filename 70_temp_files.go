@@ -0,0 +1,74 @@
+// На протяжении выполнения программы часто нужно создавать
+// данные, которые не нужны после её завершения. *Временные
+// файлы и директории* полезны для этой цели, поскольку они
+// не загрязняют файловую систему со временем.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+func check(e error) {
+	if e != nil {
+		panic(e)
+	}
+}
+
+func main() {
+
+	// Самый простой способ создать временный файл — вызвать
+	// `os.CreateTemp`. Он создаёт файл _и_ открывает его для
+	// чтения и записи. Мы передаём `""` в качестве первого
+	// аргумента, поэтому `os.CreateTemp` создаст файл в
+	// директории по умолчанию для нашей ОС (`os.TempDir`).
+	f, err := os.CreateTemp("", "sample")
+	check(err)
+
+	// Выводим имя временного файла. В Unix-подобных ОС
+	// директория, скорее всего, будет `/tmp`, но её выбирает ОС,
+	// поэтому печатаем только имя. Оно начинается с префикса,
+	// переданного вторым аргументом, а остаток выбирается
+	// случайно — так параллельные вызовы `os.CreateTemp` никогда
+	// не получат одно и то же имя и не затрут чужой файл.
+	fmt.Println("Temp file name:", filepath.Base(f.Name()))
+
+	// Удаляем файл после завершения. ОС, скорее всего, удалит
+	// временные файлы сама через некоторое время, но хорошей
+	// практикой будет сделать это явно. Отложенные вызовы
+	// выполняются в обратном порядке, поэтому файл сначала
+	// закроется, а потом удалится.
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	// Можем записать данные в файл.
+	_, err = f.Write([]byte{1, 2, 3, 4})
+	check(err)
+
+	// Если мы собираемся записать много временных файлов,
+	// можно предпочесть создать временную _директорию_.
+	// Аргументы `os.MkdirTemp` такие же, как у `CreateTemp`,
+	// но она возвращает _имя_ директории, а не открытый файл.
+	dname, err := os.MkdirTemp("", "sampledir")
+	check(err)
+	fmt.Println("Temp dir name:", filepath.Base(dname))
+
+	// `os.RemoveAll` удалит директорию вместе со всем
+	// содержимым.
+	defer os.RemoveAll(dname)
+
+	// Теперь можно синтезировать имена временных файлов,
+	// добавляя к ним префикс нашей временной директории:
+	// внутри неё коллизий с другими программами уже не будет.
+	fname := filepath.Join(dname, "file1")
+	err = os.WriteFile(fname, []byte{1, 2}, 0666)
+	check(err)
+
+	entries, err := os.ReadDir(dname)
+	check(err)
+	for _, e := range entries {
+		fmt.Println("In temp dir:", e.Name())
+	}
+}
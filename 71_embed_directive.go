@@ -0,0 +1,69 @@
+// `//go:embed` — это [директива компилятора](https://pkg.go.dev/cmd/compile#hdr-Compiler_Directives),
+// которая позволяет программам включать произвольные файлы
+// и папки в бинарный файл Go во время сборки. Подробнее о
+// директиве embed можно прочитать
+// [здесь](https://pkg.go.dev/embed).
+//
+// Это не имеет отношения к встраиванию структур из
+// [примера](23_struct_embedding.go): там одна структура
+// включает поля и методы другой, а здесь содержимое файлов
+// с диска становится частью программы при компиляции.
+
+package main
+
+// Импортируем пакет `embed`; если вы не используете
+// экспортированные идентификаторы из этого пакета, можно
+// сделать пустой импорт: `_ "embed"`.
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+)
+
+// Директивы `embed` принимают пути относительно директории,
+// содержащей исходный файл Go. Эта директива встраивает
+// содержимое файла в переменную `string`, стоящую сразу
+// после неё. Файл читается при сборке: менять его после
+// компиляции бесполезно, а если его нет — сборка упадёт.
+//
+//go:embed folder/single_file.txt
+var fileString string
+
+// Или встраиваем содержимое файла в `[]byte`.
+//
+//go:embed folder/single_file.txt
+var fileByte []byte
+
+// Можно также встроить несколько файлов или даже папки с
+// использованием шаблонов. Для этого используется переменная
+// типа [embed.FS](https://pkg.go.dev/embed#FS), которая
+// реализует простую виртуальную файловую систему.
+//
+//go:embed folder/single_file.txt
+//go:embed folder/*.hash
+var folder embed.FS
+
+func main() {
+
+	// Выводим содержимое `single_file.txt`.
+	fmt.Print(fileString)
+	fmt.Print(string(fileByte))
+
+	// Получаем некоторые файлы из встроенной папки.
+	content1, _ := folder.ReadFile("folder/file1.hash")
+	fmt.Print(string(content1))
+
+	// `embed.FS` реализует `fs.FS`, поэтому работает с функциями
+	// пакета `io/fs` — как и любая другая файловая система.
+	content2, err := fs.ReadFile(folder, "folder/file2.hash")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Print(string(content2))
+
+	// Содержимое встроенной папки можно перечислить.
+	entries, _ := fs.ReadDir(folder, "folder")
+	for _, e := range entries {
+		fmt.Println(e.Name())
+	}
+}
@@ -0,0 +1,32 @@
+// Модульное тестирование — важная часть написания
+// принципиальных программ на Go. Пакет `testing`
+// предоставляет инструменты, необходимые для написания
+// модульных тестов, а команда `go test` запускает тесты.
+//
+// Тестируемый код находится здесь, а сами тесты — в файле
+// [72_testing_test.go](72_testing_test.go): файлы с суффиксом
+// `_test.go` компилируются только командой `go test`.
+// Поскольку все примеры в этой директории — отдельные
+// программы пакета `main`, тесты запускаются для пары файлов:
+//
+//	$ go test -v 72_testing.go 72_testing_test.go
+//	$ go test -bench=. 72_testing.go 72_testing_test.go
+
+package main
+
+import "fmt"
+
+// Мы будем тестировать эту простую реализацию целочисленного
+// минимума. Обычно тестируемый код находится в исходном
+// файле с именем вроде `intutils.go`, а тестовый файл для
+// него называется `intutils_test.go`.
+func IntMin(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func main() {
+	fmt.Println(IntMin(2, -2))
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+)
+
+// Тест создаётся написанием функции с именем, начинающимся
+// с `Test`. Через `*testing.T` тест сообщает о результатах:
+// `t.Errorf` отмечает тест как проваленный и продолжает его,
+// а `t.Fatalf` — проваливает и сразу останавливает.
+func TestIntMinBasic(t *testing.T) {
+	ans := IntMin(2, -2)
+	if ans != -2 {
+		t.Errorf("IntMin(2, -2) = %d; want -2", ans)
+	}
+}
+
+// Написание тестов может быть повторяющимся, поэтому идиоматично
+// использовать _табличный стиль_: входные данные и ожидаемые
+// результаты перечисляются в таблице, а один цикл перебирает
+// их и выполняет проверку.
+func TestIntMinTableDriven(t *testing.T) {
+	var tests = []struct {
+		a, b int
+		want int
+	}{
+		{0, 1, 0},
+		{1, 0, 0},
+		{2, -2, -2},
+		{0, -1, -1},
+		{-1, 0, -1},
+		{3, 3, 3},
+	}
+
+	// `t.Run` запускает _подтест_ — по одному на каждую строку
+	// таблицы. Подтесты выводятся отдельно в `go test -v`,
+	// проваливаются независимо друг от друга, и любой из них
+	// можно запустить по имени через `-run`.
+	for _, tt := range tests {
+		testname := fmt.Sprintf("%d,%d", tt.a, tt.b)
+		t.Run(testname, func(t *testing.T) {
+			ans := IntMin(tt.a, tt.b)
+			if ans != tt.want {
+				t.Errorf("got %d, want %d", ans, tt.want)
+			}
+		})
+	}
+}
+
+// Бенчмарки обычно находятся в файлах `_test.go`, а их имена
+// начинаются с `Benchmark`. Любой код, необходимый для запуска
+// бенчмарка, но который не нужно измерять, идёт до этого цикла.
+func BenchmarkIntMin(b *testing.B) {
+	// `go test -bench` вызывает функцию несколько раз, подбирая
+	// `b.N` так, чтобы замер длился достаточно долго для
+	// точного результата; цикл выполняет измеряемый код `b.N` раз.
+	for i := 0; i < b.N; i++ {
+		IntMin(1, 2)
+	}
+}
@@ -0,0 +1,55 @@
+// [_Аргументы командной строки_](https://en.wikipedia.org/wiki/Command-line_interface#Arguments)
+// — распространённый способ параметризации выполнения программ.
+// Например, `go run hello.go` использует аргументы `run` и
+// `hello.go` для программы `go`.
+//
+//	$ go build 73_command_line_arguments.go
+//	$ ./73_command_line_arguments a b c d
+
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+// splitArgs разделяет срез аргументов в формате `os.Args` на
+// путь к программе и собственно аргументы. Функция принимает
+// срез, а не читает `os.Args` сама, поэтому её можно вызвать
+// с любыми данными.
+func splitArgs(args []string) (prog string, rest []string) {
+	if len(args) == 0 {
+		return "", nil
+	}
+	return args[0], args[1:]
+}
+
+func main() {
+
+	// `os.Args` предоставляет доступ к необработанным аргументам
+	// командной строки. Обратите внимание, что первое значение
+	// в этом срезе — путь к программе, а `os.Args[1:]`
+	// содержит аргументы программы. При `go run` это путь к
+	// временному бинарному файлу, который собрал `go`.
+	argsWithProg := os.Args
+	argsWithoutProg := os.Args[1:]
+
+	fmt.Println(argsWithProg)
+	fmt.Println(argsWithoutProg)
+
+	// Можно получить отдельные аргументы с помощью обычной
+	// индексации. Индекс за пределами среза вызовет панику,
+	// поэтому сначала проверяем длину.
+	if len(os.Args) > 3 {
+		arg := os.Args[3]
+		fmt.Println(arg)
+	}
+
+	// Реальные аргументы зависят от того, как запустили
+	// программу, поэтому покажем то же самое на фиксированном
+	// срезе.
+	prog, rest := splitArgs([]string{"./prog", "a", "b", "c", "d"})
+	fmt.Println("prog:", prog)
+	fmt.Println("args:", rest, len(rest))
+	fmt.Println("third:", rest[2])
+}
@@ -0,0 +1,118 @@
+// Запишем целое число в системе счисления с основанием от 2
+// до 36 и разберём его обратно. Цифры больше 9 обозначаются
+// латинскими буквами: `a` = 10, ..., `z` = 35. В стандартной
+// библиотеке то же самое делают `strconv.FormatInt` и
+// `strconv.ParseInt`; здесь мы реализуем это вручную.
+
+package main
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+const digits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+func checkBase(base int) error {
+	if base < 2 || base > 36 {
+		return fmt.Errorf("invalid base %d: must be in 2..36", base)
+	}
+	return nil
+}
+
+// ToBase возвращает запись `n` в системе с основанием `base`.
+// Отрицательные числа получают знак `-`.
+func ToBase(n, base int) (string, error) {
+	if err := checkBase(base); err != nil {
+		return "", err
+	}
+	if n == 0 {
+		return "0", nil
+	}
+
+	// Цифры получаются остатками от деления, начиная с младшей,
+	// поэтому собираем их с конца буфера. Остаток отрицательного
+	// числа отрицателен, и мы берём его модуль — так обходится
+	// и самый маленький `int`, модуль которого в `int` не помещается.
+	var buf [65]byte
+	i := len(buf)
+	neg := n < 0
+	for n != 0 {
+		d := n % base
+		if d < 0 {
+			d = -d
+		}
+		i--
+		buf[i] = digits[d]
+		n /= base
+	}
+	if neg {
+		i--
+		buf[i] = '-'
+	}
+	return string(buf[i:]), nil
+}
+
+// FromBase разбирает запись `s` в системе с основанием `base`.
+// Регистр букв не важен. Пустая строка и символы, которые не
+// являются цифрами этой системы, — ошибка, как и число, которое
+// не помещается в `int`.
+func FromBase(s string, base int) (int, error) {
+	if err := checkBase(base); err != nil {
+		return 0, err
+	}
+	body, neg := strings.CutPrefix(s, "-")
+	if body == "" {
+		return 0, fmt.Errorf("invalid number %q", s)
+	}
+
+	// Модуль накапливаем в `uint64`: у самого маленького `int`
+	// модуль на единицу больше `math.MaxInt`. Перед каждым шагом
+	// проверяем, что `n*base + d` не превысит предел, — после
+	// умножения переполнение уже не заметить.
+	limit := uint64(math.MaxInt)
+	if neg {
+		limit++
+	}
+	var n uint64
+	for _, r := range strings.ToLower(body) {
+		d := strings.IndexRune(digits, r)
+		if d < 0 || d >= base {
+			return 0, fmt.Errorf("invalid digit %q for base %d", r, base)
+		}
+		if n > (limit-uint64(d))/uint64(base) {
+			return 0, fmt.Errorf("number %q out of int range", s)
+		}
+		n = n*uint64(base) + uint64(d)
+	}
+	if neg {
+		// Беззнаковое отрицание даёт дополнительный код, так что
+		// и `math.MinInt` получается правильно.
+		return int(-n), nil
+	}
+	return int(n), nil
+}
+
+func main() {
+	for _, c := range []struct{ n, base int }{{10, 2}, {255, 16}, {-255, 16}, {1295, 36}} {
+		s, _ := ToBase(c.n, c.base)
+		back, _ := FromBase(s, c.base)
+		fmt.Printf("%d in base %d = %s -> %d\n", c.n, c.base, s, back)
+	}
+
+	_, err := ToBase(10, 1)
+	fmt.Println("error:", err)
+	_, err = FromBase("102", 2)
+	fmt.Println("error:", err)
+	_, err = FromBase("zzzzzzzzzzzzzzzz", 36)
+	fmt.Println("error:", err)
+	_, err = FromBase("1"+strings.Repeat("0", 64), 2)
+	fmt.Println("error:", err)
+
+	// Граничные значения `int` по-прежнему разбираются.
+	maxS, _ := ToBase(math.MaxInt, 36)
+	minS, _ := ToBase(math.MinInt, 36)
+	fmt.Println(FromBase(maxS, 36))
+	fmt.Println(FromBase(minS, 36))
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestToBase(t *testing.T) {
+	var tests = []struct {
+		n, base int
+		want    string
+	}{
+		{0, 2, "0"},
+		{10, 2, "1010"},
+		{255, 16, "ff"},
+		{-255, 16, "-ff"},
+		{1295, 36, "zz"},
+		{7, 10, "7"},
+	}
+	for _, tt := range tests {
+		got, err := ToBase(tt.n, tt.base)
+		if err != nil || got != tt.want {
+			t.Errorf("ToBase(%d, %d) = %q, %v; want %q, nil", tt.n, tt.base, got, err, tt.want)
+		}
+	}
+}
+
+func TestBaseMatchesStrconv(t *testing.T) {
+	// Граничные значения и их соседи во всех основаниях.
+	for _, n := range []int{math.MinInt, math.MinInt + 1, -1, 0, 1, math.MaxInt - 1, math.MaxInt} {
+		for base := 2; base <= 36; base++ {
+			want := strconv.FormatInt(int64(n), base)
+			got, err := ToBase(n, base)
+			if err != nil || got != want {
+				t.Errorf("ToBase(%d, %d) = %q, %v; want %q, nil", n, base, got, err, want)
+			}
+			if back, err := FromBase(got, base); err != nil || back != n {
+				t.Errorf("FromBase(%q, %d) = %d, %v; want %d, nil", got, base, back, err, n)
+			}
+		}
+	}
+}
+
+func TestFromBaseCase(t *testing.T) {
+	if n, err := FromBase("FF", 16); err != nil || n != 255 {
+		t.Errorf(`FromBase("FF", 16) = %d, %v; want 255, nil`, n, err)
+	}
+}
+
+func TestBaseErrors(t *testing.T) {
+	for _, base := range []int{0, 1, 37} {
+		if _, err := ToBase(10, base); err == nil {
+			t.Errorf("ToBase(10, %d) error = nil; want error", base)
+		}
+		if _, err := FromBase("10", base); err == nil {
+			t.Errorf(`FromBase("10", %d) error = nil; want error`, base)
+		}
+	}
+
+	var tests = []struct {
+		s    string
+		base int
+	}{
+		{"", 10},
+		{"-", 10},
+		{"102", 2},
+		{"1 0", 10},
+		{"zzzzzzzzzzzzzzzz", 36},
+		{"1" + strings.Repeat("0", 64), 2},
+		{"9223372036854775808", 10},
+		{"-9223372036854775809", 10},
+	}
+	for _, tt := range tests {
+		if n, err := FromBase(tt.s, tt.base); err == nil {
+			t.Errorf("FromBase(%q, %d) = %d, nil; want error", tt.s, tt.base, n)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+// _Пакетная обработка_: вместо того чтобы обрабатывать (например,
+// записывать в базу) каждое значение отдельно, мы копим их в пакеты.
+// Пакет отправляется, когда он заполнен или когда первое значение
+// в нём ждёт слишком долго — так задержка остаётся ограниченной
+// даже при редком потоке данных.
+
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+// Batch читает значения из `in` и отправляет в возвращаемый канал
+// пакеты размером не больше `maxSize`. Неполный пакет отправляется
+// через `maxWait` после того, как в него попало первое значение.
+// Когда `in` закрыт, оставшиеся значения отправляются последним
+// пакетом, и выходной канал закрывается. При `maxSize < 1`
+// пакет никогда бы не заполнился, поэтому `Batch` паникует, как
+// `NewLRU` с нулевой ёмкостью.
+func Batch[T any](in <-chan T, maxSize int, maxWait time.Duration) <-chan []T {
+	if maxSize < 1 {
+		panic(fmt.Sprintf("Batch: maxSize must be at least 1, got %d", maxSize))
+	}
+	out := make(chan []T)
+
+	go func() {
+		defer close(out)
+
+		var batch []T
+		// Канал `nil` никогда не готов, поэтому, пока пакет
+		// пуст, случай таймера в `select` просто не срабатывает.
+		var timeout <-chan time.Time
+
+		flush := func() {
+			out <- batch
+			batch = nil
+			timeout = nil
+		}
+
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					if len(batch) > 0 {
+						flush()
+					}
+					return
+				}
+				if len(batch) == 0 {
+					timeout = time.After(maxWait)
+				}
+				batch = append(batch, v)
+				if len(batch) == maxSize {
+					flush()
+				}
+			case <-timeout:
+				flush()
+			}
+		}
+	}()
+
+	return out
+}
+
+func main() {
+	in := make(chan int)
+	out := Batch(in, 3, 100*time.Millisecond)
+
+	go func() {
+		// Пять значений подряд: первые три уходят полным
+		// пакетом, ещё два — по тайм-ауту.
+		for i := 1; i <= 5; i++ {
+			in <- i
+		}
+		time.Sleep(200 * time.Millisecond)
+
+		// Одно значение, после которого канал закрывается:
+		// оно уходит последним пакетом, не дожидаясь тайм-аута.
+		in <- 6
+		close(in)
+	}()
+
+	for b := range out {
+		fmt.Println("batch:", b)
+	}
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+// receive ждёт следующий пакет, но не дольше секунды, чтобы
+// ошибка в `Batch` не подвесила тест.
+func receive[T any](t *testing.T, out <-chan []T) ([]T, bool) {
+	t.Helper()
+	select {
+	case b, ok := <-out:
+		return b, ok
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for a batch")
+		return nil, false
+	}
+}
+
+func TestBatchSizeTriggered(t *testing.T) {
+	in := make(chan int)
+	// Тайм-аут огромный: пакеты могут уйти только по размеру.
+	out := Batch(in, 3, time.Hour)
+
+	go func() {
+		for i := 1; i <= 6; i++ {
+			in <- i
+		}
+	}()
+
+	for _, want := range [][]int{{1, 2, 3}, {4, 5, 6}} {
+		if b, _ := receive(t, out); !slices.Equal(b, want) {
+			t.Errorf("batch = %v; want %v", b, want)
+		}
+	}
+	close(in)
+	if b, ok := receive(t, out); ok {
+		t.Errorf("got extra batch %v; want the output closed", b)
+	}
+}
+
+func TestBatchTimeTriggered(t *testing.T) {
+	in := make(chan int)
+	out := Batch(in, 10, 20*time.Millisecond)
+
+	// Два значения и тишина: неполный пакет уходит по тайм-ауту,
+	// хотя `in` не закрыт.
+	in <- 1
+	in <- 2
+	if b, _ := receive(t, out); !slices.Equal(b, []int{1, 2}) {
+		t.Errorf("batch = %v; want [1 2]", b)
+	}
+
+	// Закрытие `in` отправляет остаток и закрывает выход.
+	in <- 3
+	close(in)
+	if b, _ := receive(t, out); !slices.Equal(b, []int{3}) {
+		t.Errorf("last batch = %v; want [3]", b)
+	}
+	if _, ok := receive(t, out); ok {
+		t.Error("output not closed after in was closed")
+	}
+}
+
+func TestBatchRejectsNonPositiveSize(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Batch did not panic on maxSize 0")
+		}
+	}()
+	Batch(make(chan int), 0, time.Second)
+}
@@ -0,0 +1,75 @@
+// [_Фильтр Блума_](https://en.wikipedia.org/wiki/Bloom_filter) — это
+// вероятностное множество. Он занимает фиксированный объём памяти
+// независимо от числа элементов, но за это платит точностью:
+// ответ "нет" всегда верен, а ответ "да" означает лишь "возможно".
+
+package main
+
+import (
+	"fmt"
+	"hash/fnv"
+)
+
+// Размер битового массива в битах. Чем он больше относительно
+// числа добавленных элементов, тем реже ложные срабатывания.
+const bloomBits = 1024
+
+// `BloomFilter` хранит биты в массиве `uint64`: бит `i`
+// находится в слове `i/64` на позиции `i%64`. Массив
+// фиксированного размера — часть значения структуры, поэтому
+// нулевое значение `BloomFilter` сразу готово к работе.
+type BloomFilter struct {
+	bits [bloomBits / 64]uint64
+}
+
+// positions вычисляет номера битов для строки. Вместо нескольких
+// независимых хэш-функций мы берём две (FNV-1 и FNV-1a) и
+// комбинируем их как `h1 + i*h2` — это стандартный приём,
+// дающий `k` хэшей по цене двух.
+func positions(s string) [3]uint64 {
+	h1 := fnv.New64()
+	h1.Write([]byte(s))
+	h2 := fnv.New64a()
+	h2.Write([]byte(s))
+	a, b := h1.Sum64(), h2.Sum64()
+
+	var pos [3]uint64
+	for i := range pos {
+		pos[i] = (a + uint64(i)*b) % bloomBits
+	}
+	return pos
+}
+
+// Add устанавливает биты для строки.
+func (f *BloomFilter) Add(s string) {
+	for _, p := range positions(s) {
+		f.bits[p/64] |= 1 << (p % 64)
+	}
+}
+
+// MightContain возвращает `false`, только если строка точно
+// не добавлялась. Результат `true` может быть _ложным
+// срабатыванием_: все нужные биты могли установить другие
+// строки. Ложноотрицательных ответов не бывает — биты никогда
+// не сбрасываются.
+func (f *BloomFilter) MightContain(s string) bool {
+	for _, p := range positions(s) {
+		if f.bits[p/64]&(1<<(p%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func main() {
+	var f BloomFilter
+	f.Add("apple")
+	f.Add("peach")
+
+	// Добавленные строки находятся всегда.
+	fmt.Println(f.MightContain("apple"), f.MightContain("peach"))
+
+	// Для строки, которой не было, ответ почти наверняка `false`.
+	// Проверки на ложные срабатывания — в `bloom_filter_test.go`.
+	fmt.Println(f.MightContain("pear"))
+}
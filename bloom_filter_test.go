@@ -0,0 +1,47 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestBloomFilterNoFalseNegatives(t *testing.T) {
+	var f BloomFilter
+	for i := 0; i < 100; i++ {
+		f.Add(fmt.Sprint("item-", i))
+	}
+	for i := 0; i < 100; i++ {
+		s := fmt.Sprint("item-", i)
+		if !f.MightContain(s) {
+			t.Errorf("MightContain(%q) = false after Add", s)
+		}
+	}
+}
+
+func TestBloomFilterEmpty(t *testing.T) {
+	var f BloomFilter
+	if f.MightContain("apple") {
+		t.Error(`MightContain("apple") = true on empty filter`)
+	}
+}
+
+func TestBloomFilterFalsePositiveRate(t *testing.T) {
+	var f BloomFilter
+	for i := 0; i < 100; i++ {
+		f.Add(fmt.Sprint("item-", i))
+	}
+
+	// При 100 элементах, 1024 битах и трёх хэшах теория даёт
+	// около 1.6%; наши простые хэши на похожих строках дают
+	// несколько процентов. Граница в 10% ловит поломку хэширования.
+	const n = 10000
+	falsePositives := 0
+	for i := 0; i < n; i++ {
+		if f.MightContain(fmt.Sprint("other-", i)) {
+			falsePositives++
+		}
+	}
+	if rate := float64(falsePositives) / n; rate > 0.10 {
+		t.Errorf("false positive rate = %.2f%%; want at most 10%%", rate*100)
+	}
+}
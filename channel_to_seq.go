@@ -0,0 +1,68 @@
+// Каналы и итераторы — два способа выдавать значения по одному.
+// `range` работает с обоими, но функции вроде `slices.Collect`
+// принимают только итераторы. Небольшой мост превращает канал
+// в `iter.Seq`.
+
+package main
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+)
+
+// ChannelToSeq возвращает итератор по значениям из `ch`, который
+// заканчивается, когда канал закрыт. После `break` итератор
+// перестаёт читать из канала; непрочитанные значения остаются в
+// нём, а отправитель, если он ещё пишет, должен узнать об
+// остановке сам, например через отдельный канал `done`.
+func ChannelToSeq[T any](ch <-chan T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range ch {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func main() {
+	// Буферизованный канал, закрытый после нескольких отправок:
+	// итератор отдаёт всё, что в нём было, и завершается.
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+	fmt.Println(slices.Collect(ChannelToSeq(ch)))
+
+	// Отправитель в горутине, который останавливается по `done`.
+	words := make(chan string)
+	done := make(chan struct{})
+	exited := make(chan struct{})
+	go func() {
+		defer close(exited)
+		defer close(words)
+		for _, w := range []string{"a", "b", "stop", "c", "d"} {
+			select {
+			case words <- w:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	for w := range ChannelToSeq(words) {
+		if w == "stop" {
+			break
+		}
+		fmt.Println(w)
+	}
+	close(done)
+	<-exited
+
+	// Отправитель увидел `done` и закрыл канал; остаток
+	// значений так и не был отправлен.
+	_, ok := <-words
+	fmt.Println("more values:", ok)
+}
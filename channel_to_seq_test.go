@@ -0,0 +1,56 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestChannelToSeq(t *testing.T) {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+	if got := slices.Collect(ChannelToSeq(ch)); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("ChannelToSeq() = %v; want [1 2 3]", got)
+	}
+}
+
+func TestChannelToSeqClosedEmpty(t *testing.T) {
+	ch := make(chan int)
+	close(ch)
+	if got := slices.Collect(ChannelToSeq(ch)); len(got) != 0 {
+		t.Errorf("ChannelToSeq(closed) = %v; want []", got)
+	}
+}
+
+func TestChannelToSeqBreak(t *testing.T) {
+	ch := make(chan int, 4)
+	for i := 1; i <= 4; i++ {
+		ch <- i
+	}
+	close(ch)
+
+	for v := range ChannelToSeq(ch) {
+		if v == 2 {
+			break
+		}
+	}
+	// После break непрочитанные значения остаются в канале.
+	if got := slices.Collect(ChannelToSeq(ch)); !slices.Equal(got, []int{3, 4}) {
+		t.Errorf("remaining = %v; want [3 4]", got)
+	}
+}
+
+func TestChannelToSeqGoroutine(t *testing.T) {
+	ch := make(chan string)
+	go func() {
+		defer close(ch)
+		for _, s := range []string{"a", "b", "c"} {
+			ch <- s
+		}
+	}()
+	if got := slices.Collect(ChannelToSeq(ch)); !slices.Equal(got, []string{"a", "b", "c"}) {
+		t.Errorf("ChannelToSeq() = %v; want [a b c]", got)
+	}
+}
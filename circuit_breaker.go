@@ -0,0 +1,146 @@
+// [_Предохранитель_](https://en.wikipedia.org/wiki/Circuit_breaker_design_pattern)
+// (circuit breaker) защищает программу от многократных обращений
+// к сервису, который сейчас не работает. После нескольких ошибок
+// подряд предохранитель "размыкается" и сразу отклоняет вызовы,
+// а через некоторое время пробует снова.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Состояния предохранителя — перечисление по образцу `ServerState`.
+type BreakerState int
+
+const (
+	// Вызовы проходят, ошибки подсчитываются.
+	StateClosed BreakerState = iota
+	// Вызовы отклоняются до окончания паузы.
+	StateOpen
+	// Пауза прошла: пропускаем пробный вызов.
+	StateHalfOpen
+)
+
+var breakerStateName = map[BreakerState]string{
+	StateClosed:   "closed",
+	StateOpen:     "open",
+	StateHalfOpen: "half-open",
+}
+
+func (s BreakerState) String() string {
+	return breakerStateName[s]
+}
+
+// Сентинельная ошибка для отклонённых вызовов.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// `CircuitBreaker` размыкается после `threshold` ошибок подряд и
+// остаётся разомкнутым `cooldown`. Текущее время берётся из `now`,
+// чтобы в примере (и в тестах) можно было "перематывать" часы.
+// Один предохранитель обычно защищает вызовы из многих горутин,
+// поэтому его состояние охраняет мьютекс, как в `Debounced`.
+type CircuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mu       sync.Mutex
+	state    BreakerState
+	failures int
+	openedAt time.Time
+	// probing — пробный вызов в полуразомкнутом состоянии уже идёт.
+	probing bool
+}
+
+// NewCircuitBreaker создаёт замкнутый предохранитель. Порог меньше
+// единицы не имеет смысла — цепь размыкалась бы без единой
+// ошибки, — поэтому при `threshold < 1` функция паникует.
+func NewCircuitBreaker(threshold int, cooldown time.Duration) *CircuitBreaker {
+	if threshold < 1 {
+		panic(fmt.Sprintf("NewCircuitBreaker: threshold must be at least 1, got %d", threshold))
+	}
+	return &CircuitBreaker{threshold: threshold, cooldown: cooldown, now: time.Now}
+}
+
+// State возвращает текущее состояние с учётом истёкшей паузы.
+func (cb *CircuitBreaker) State() BreakerState {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	return cb.currentState()
+}
+
+// currentState — то же, что `State`, для вызова под мьютексом.
+func (cb *CircuitBreaker) currentState() BreakerState {
+	if cb.state == StateOpen && cb.now().Sub(cb.openedAt) >= cb.cooldown {
+		cb.state = StateHalfOpen
+	}
+	return cb.state
+}
+
+// Call вызывает `fn`, если предохранитель это позволяет, и
+// возвращает её ошибку. В разомкнутом состоянии `fn` не
+// вызывается, а возвращается `ErrCircuitOpen`. В полуразомкнутом
+// состоянии проходит только один пробный вызов, остальные
+// отклоняются, пока он не завершится. Сама `fn` выполняется без
+// мьютекса, чтобы медленный вызов не задерживал остальные.
+func (cb *CircuitBreaker) Call(fn func() error) error {
+	cb.mu.Lock()
+	state := cb.currentState()
+	if state == StateOpen || state == StateHalfOpen && cb.probing {
+		cb.mu.Unlock()
+		return ErrCircuitOpen
+	}
+	probe := state == StateHalfOpen
+	cb.probing = probe
+	cb.mu.Unlock()
+
+	err := fn()
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	if probe {
+		cb.probing = false
+	}
+	if err == nil {
+		// Любой успех, в том числе пробный, замыкает цепь.
+		cb.state = StateClosed
+		cb.failures = 0
+		return nil
+	}
+
+	cb.failures++
+	// Неудачный пробный вызов сразу размыкает цепь снова.
+	if probe || cb.failures >= cb.threshold {
+		cb.state = StateOpen
+		cb.openedAt = cb.now()
+	}
+	return err
+}
+
+func main() {
+	// "Часы", которые идут только тогда, когда мы их переводим.
+	clock := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cb := NewCircuitBreaker(3, 10*time.Second)
+	cb.now = func() time.Time { return clock }
+
+	fail := func() error { return errors.New("service unavailable") }
+	ok := func() error { return nil }
+
+	// Три ошибки подряд размыкают цепь.
+	for i := 0; i < 3; i++ {
+		fmt.Println("call:", cb.Call(fail), "->", cb.State())
+	}
+
+	// В разомкнутом состоянии вызов отклоняется, `ok` не выполняется.
+	fmt.Println("call:", cb.Call(ok), "->", cb.State())
+
+	// После паузы предохранитель пропускает пробный вызов,
+	// и успех возвращает его в замкнутое состояние.
+	clock = clock.Add(10 * time.Second)
+	fmt.Println("state after cooldown:", cb.State())
+	fmt.Println("call:", cb.Call(ok), "->", cb.State())
+}
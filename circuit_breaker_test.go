@@ -0,0 +1,155 @@
+package main
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+var errService = errors.New("service unavailable")
+
+// newTestBreaker возвращает предохранитель с часами, которые
+// двигаются только через возвращённый указатель.
+func newTestBreaker(threshold int, cooldown time.Duration) (*CircuitBreaker, *time.Time) {
+	clock := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cb := NewCircuitBreaker(threshold, cooldown)
+	cb.now = func() time.Time { return clock }
+	return cb, &clock
+}
+
+func fail() error    { return errService }
+func succeed() error { return nil }
+
+func TestCircuitBreakerTransitions(t *testing.T) {
+	cb, clock := newTestBreaker(2, 10*time.Second)
+
+	// closed: ошибки проходят наружу, пока не достигнут порог.
+	if err := cb.Call(fail); err != errService {
+		t.Fatalf("Call = %v; want %v", err, errService)
+	}
+	if s := cb.State(); s != StateClosed {
+		t.Fatalf("State after 1 failure = %v; want %v", s, StateClosed)
+	}
+
+	// closed -> open
+	cb.Call(fail)
+	if s := cb.State(); s != StateOpen {
+		t.Fatalf("State after 2 failures = %v; want %v", s, StateOpen)
+	}
+
+	// В разомкнутом состоянии fn не вызывается.
+	called := false
+	err := cb.Call(func() error { called = true; return nil })
+	if !errors.Is(err, ErrCircuitOpen) || called {
+		t.Fatalf("Call while open = %v, called = %v; want %v, false", err, called, ErrCircuitOpen)
+	}
+
+	// До окончания паузы цепь остаётся разомкнутой.
+	*clock = clock.Add(9 * time.Second)
+	if s := cb.State(); s != StateOpen {
+		t.Fatalf("State before cooldown = %v; want %v", s, StateOpen)
+	}
+
+	// open -> half-open
+	*clock = clock.Add(time.Second)
+	if s := cb.State(); s != StateHalfOpen {
+		t.Fatalf("State after cooldown = %v; want %v", s, StateHalfOpen)
+	}
+
+	// half-open -> closed
+	if err := cb.Call(succeed); err != nil {
+		t.Fatalf("probe Call = %v; want nil", err)
+	}
+	if s := cb.State(); s != StateClosed {
+		t.Fatalf("State after probe = %v; want %v", s, StateClosed)
+	}
+
+	// Счётчик ошибок сброшен: одна ошибка не размыкает цепь.
+	cb.Call(fail)
+	if s := cb.State(); s != StateClosed {
+		t.Errorf("State after reset and 1 failure = %v; want %v", s, StateClosed)
+	}
+}
+
+func TestCircuitBreakerFailedProbeReopens(t *testing.T) {
+	cb, clock := newTestBreaker(1, time.Second)
+
+	cb.Call(fail)
+	*clock = clock.Add(time.Second)
+	if err := cb.Call(fail); err != errService {
+		t.Fatalf("probe Call = %v; want %v", err, errService)
+	}
+	if s := cb.State(); s != StateOpen {
+		t.Fatalf("State after failed probe = %v; want %v", s, StateOpen)
+	}
+
+	// Пауза отсчитывается заново от неудачной пробы.
+	*clock = clock.Add(time.Second - time.Nanosecond)
+	if s := cb.State(); s != StateOpen {
+		t.Errorf("State before new cooldown = %v; want %v", s, StateOpen)
+	}
+}
+
+func TestCircuitBreakerSingleProbe(t *testing.T) {
+	cb, clock := newTestBreaker(1, time.Second)
+	cb.Call(fail)
+	*clock = clock.Add(time.Second)
+
+	// Пока идёт пробный вызов, остальные отклоняются.
+	started := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- cb.Call(func() error {
+			close(started)
+			<-release
+			return nil
+		})
+	}()
+	<-started
+
+	if err := cb.Call(succeed); !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("Call during probe = %v; want %v", err, ErrCircuitOpen)
+	}
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatalf("probe Call = %v; want nil", err)
+	}
+	if s := cb.State(); s != StateClosed {
+		t.Errorf("State after probe = %v; want %v", s, StateClosed)
+	}
+}
+
+func TestCircuitBreakerConcurrentCalls(t *testing.T) {
+	cb, _ := newTestBreaker(1000, time.Second)
+
+	// Запускается с -race: состояние разделяется между горутинами.
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			cb.Call(fail)
+			cb.State()
+		}()
+	}
+	wg.Wait()
+
+	if cb.failures != 100 {
+		t.Errorf("failures = %d; want 100", cb.failures)
+	}
+}
+
+func TestNewCircuitBreakerRejectsThreshold(t *testing.T) {
+	for _, threshold := range []int{0, -1} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewCircuitBreaker(%d, ...) did not panic", threshold)
+				}
+			}()
+			NewCircuitBreaker(threshold, time.Second)
+		}()
+	}
+}
@@ -0,0 +1,54 @@
+// Найдём самый длинный общий префикс нескольких строк.
+// Сравнивать строки побайтово опасно: две разные руны UTF-8
+// могут начинаться с одинаковых байтов, и префикс "разрезал"
+// бы символ пополам. Поэтому сравниваем по рунам.
+
+package main
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// CommonPrefix возвращает самый длинный общий префикс всех
+// переданных строк. Для одной строки это она сама, а без
+// аргументов — пустая строка.
+func CommonPrefix(strs ...string) string {
+	if len(strs) == 0 {
+		return ""
+	}
+
+	// Префикс не длиннее первой строки. Перебираем её руны
+	// и проверяем, что с той же позиции в каждой другой строке
+	// стоят те же байты. Сравниваем именно байты руны, а не
+	// декодированные значения: любые некорректные байты UTF-8
+	// декодируются в одну и ту же `utf8.RuneError`, и разные
+	// строки показались бы одинаковыми.
+	first := strs[0]
+	for i := 0; i < len(first); {
+		_, w := utf8.DecodeRuneInString(first[i:])
+		for _, s := range strs[1:] {
+			// `i` — байтовое смещение начала руны, одинаковое
+			// во всех строках, пока префикс совпадает.
+			if i+w > len(s) || s[i:i+w] != first[i:i+w] {
+				return first[:i]
+			}
+		}
+		i += w
+	}
+	return first
+}
+
+func main() {
+	fmt.Printf("%q\n", CommonPrefix("interstellar", "internet", "interval"))
+	fmt.Printf("%q\n", CommonPrefix("go", "rust"))
+	fmt.Printf("%q\n", CommonPrefix("alone"))
+	fmt.Printf("%q\n", CommonPrefix())
+
+	// "д" и "ж" в UTF-8 начинаются с одного и того же байта 0xD0,
+	// но общий префикс — только "при", без половины символа.
+	fmt.Printf("%q\n", CommonPrefix("придумать", "прижать"))
+
+	// Разные некорректные байты не считаются совпадающими.
+	fmt.Printf("%q\n", CommonPrefix("ab\xff", "ab\xfe"))
+}
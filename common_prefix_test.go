@@ -0,0 +1,29 @@
+package main
+
+import "testing"
+
+func TestCommonPrefix(t *testing.T) {
+	var tests = []struct {
+		strs []string
+		want string
+	}{
+		{[]string{"interstellar", "internet", "interval"}, "inter"},
+		{[]string{"go", "rust"}, ""},
+		{[]string{"alone"}, "alone"},
+		{nil, ""},
+		{[]string{"go", "gopher"}, "go"},
+		{[]string{"gopher", "go"}, "go"},
+		{[]string{"same", "same"}, "same"},
+		{[]string{"", "go"}, ""},
+		// Общий первый байт 0xD0 у "д" и "ж" не попадает в префикс.
+		{[]string{"придумать", "прижать"}, "при"},
+		// Разные некорректные байты не совпадают.
+		{[]string{"ab\xff", "ab\xfe"}, "ab"},
+		{[]string{"ab\xff", "ab\xff"}, "ab\xff"},
+	}
+	for _, tt := range tests {
+		if got := CommonPrefix(tt.strs...); got != tt.want {
+			t.Errorf("CommonPrefix(%q) = %q; want %q", tt.strs, got, tt.want)
+		}
+	}
+}
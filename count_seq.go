@@ -0,0 +1,72 @@
+// Объединим карты, обобщения и итераторы: посчитаем, сколько
+// раз каждое значение встречается в последовательности. Функция
+// принимает любой итератор, поэтому ей всё равно, откуда берутся
+// значения — из списка, среза или генератора.
+
+package main
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+)
+
+// Тип `List` из [примера про итераторы](25_range_over_iterators.go),
+// сокращённый до того, что нужно здесь.
+type List[T any] struct {
+	head, tail *element[T]
+}
+
+type element[T any] struct {
+	next *element[T]
+	val  T
+}
+
+func (lst *List[T]) Push(v T) {
+	if lst.tail == nil {
+		lst.head = &element[T]{val: v}
+		lst.tail = lst.head
+	} else {
+		lst.tail.next = &element[T]{val: v}
+		lst.tail = lst.tail.next
+	}
+}
+
+func (lst *List[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := lst.head; e != nil; e = e.next {
+			if !yield(e.val) {
+				return
+			}
+		}
+	}
+}
+
+// CountSeq проходит по `seq` до конца и возвращает карту
+// «значение → сколько раз встретилось». Значения должны быть
+// `comparable`, чтобы служить ключами карты. Бесконечную
+// последовательность передавать нельзя: функция не вернётся.
+func CountSeq[T comparable](seq iter.Seq[T]) map[T]int {
+	counts := make(map[T]int)
+	for v := range seq {
+		counts[v]++
+	}
+	return counts
+}
+
+func main() {
+	lst := List[string]{}
+	for _, w := range []string{"go", "rust", "go", "c", "go", "rust"} {
+		lst.Push(w)
+	}
+
+	counts := CountSeq(lst.All())
+	fmt.Println(counts)
+
+	// Отсутствующий ключ даёт нулевое значение, поэтому
+	// проверять его наличие отдельно не нужно.
+	fmt.Println("go:", counts["go"], "java:", counts["java"])
+
+	// Подойдёт и итератор из стандартной библиотеки.
+	fmt.Println(CountSeq(slices.Values([]int{1, 2, 2, 3, 3, 3})))
+}
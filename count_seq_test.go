@@ -0,0 +1,26 @@
+package main
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestCountSeq(t *testing.T) {
+	lst := List[string]{}
+	for _, w := range []string{"go", "rust", "go", "c", "go", "rust"} {
+		lst.Push(w)
+	}
+	got := CountSeq(lst.All())
+	want := map[string]int{"go": 3, "rust": 2, "c": 1}
+	if !maps.Equal(got, want) {
+		t.Errorf("CountSeq() = %v; want %v", got, want)
+	}
+}
+
+func TestCountSeqEmpty(t *testing.T) {
+	got := CountSeq(slices.Values([]int{}))
+	if got == nil || len(got) != 0 {
+		t.Errorf("CountSeq(empty) = %#v; want empty non-nil map", got)
+	}
+}
@@ -0,0 +1,87 @@
+// Разбор строки [CSV](https://en.wikipedia.org/wiki/Comma-separated_values)
+// кажется простым `strings.Split(line, ",")`, пока в данных не
+// появляются запятые. Поля с запятыми заключаются в двойные
+// кавычки, а кавычка внутри такого поля удваивается: `""`.
+// Для настоящих файлов есть пакет [encoding/csv](https://pkg.go.dev/encoding/csv),
+// а здесь мы разберём одну строку вручную.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrUnterminatedQuote = errors.New("unterminated quoted field")
+	ErrTextAfterQuote    = errors.New("text after closing quote")
+)
+
+// ParseCSVLine разбивает строку на поля. Строка перебирается
+// по рунам, а флаг `quoted` запоминает, находимся ли мы внутри
+// кавычек: там запятая — обычный символ, а не разделитель.
+// Кавычки открывают поле, только если стоят в самом его начале.
+// После закрывающей кавычки может идти лишь запятая или конец
+// строки; всё остальное — `ErrTextAfterQuote`.
+func ParseCSVLine(line string) ([]string, error) {
+	var fields []string
+	var field strings.Builder
+	quoted := false
+	// `start` — мы в начале поля; `closed` — поле было в
+	// кавычках, и они уже закрылись.
+	start, closed := true, false
+
+	runes := []rune(line)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case quoted && r == '"':
+			// Удвоенная кавычка внутри поля — это экранированная
+			// кавычка; одиночная закрывает поле.
+			if i+1 < len(runes) && runes[i+1] == '"' {
+				field.WriteRune('"')
+				i++
+			} else {
+				quoted = false
+				closed = true
+			}
+		case quoted:
+			field.WriteRune(r)
+		case r == ',':
+			fields = append(fields, field.String())
+			field.Reset()
+			start, closed = true, false
+			continue
+		case closed:
+			return nil, fmt.Errorf("%q at position %d: %w", r, i, ErrTextAfterQuote)
+		case r == '"' && start:
+			quoted = true
+		default:
+			field.WriteRune(r)
+		}
+		start = false
+	}
+
+	if quoted {
+		return nil, ErrUnterminatedQuote
+	}
+	return append(fields, field.String()), nil
+}
+
+func main() {
+	for _, line := range []string{
+		`a,b,c`,
+		`1,"Smith, John",ok`,
+		`"say ""hi""",,end`,
+		`x,"no end`,
+		`"a"b,c`,
+	} {
+		fields, err := ParseCSVLine(line)
+		if err != nil {
+			fmt.Printf("%-22s error: %v\n", line, err)
+			continue
+		}
+		fmt.Printf("%-22s %q\n", line, fields)
+	}
+}
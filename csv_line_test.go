@@ -0,0 +1,56 @@
+package main
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestParseCSVLine(t *testing.T) {
+	var tests = []struct {
+		in   string
+		want []string
+	}{
+		{`a,b,c`, []string{"a", "b", "c"}},
+		{`a,,c`, []string{"a", "", "c"}},
+		{``, []string{""}},
+		{`1,"Smith, John",ok`, []string{"1", "Smith, John", "ok"}},
+		{`"say ""hi""",end`, []string{`say "hi"`, "end"}},
+		{`"",x`, []string{"", "x"}},
+		{`"ёж, уж"`, []string{"ёж, уж"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseCSVLine(tt.in)
+			if err != nil {
+				t.Fatalf("ParseCSVLine(%q): %v", tt.in, err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ParseCSVLine(%q) = %q; want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseCSVLineErrors(t *testing.T) {
+	var tests = []struct {
+		in   string
+		want error
+	}{
+		{`x,"no end`, ErrUnterminatedQuote},
+		{`"`, ErrUnterminatedQuote},
+		{`"a"b,c`, ErrTextAfterQuote},
+		{`""x`, ErrTextAfterQuote},
+		{`"a" ,b`, ErrTextAfterQuote},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			_, err := ParseCSVLine(tt.in)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("ParseCSVLine(%q) error = %v; want %v", tt.in, err, tt.want)
+			}
+		})
+	}
+}
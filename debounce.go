@@ -0,0 +1,69 @@
+// _Устранение дребезга_ (debounce) — приём, при котором серия
+// частых вызовов превращается в один: функция срабатывает, только
+// когда вызовы прекратились на заданное время. Так, например,
+// поиск запускается не на каждое нажатие клавиши, а когда
+// пользователь перестал печатать. Реализуем его с помощью
+// замыканий и таймеров.
+
+package main
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// stopper — то, что нам нужно от таймера: возможность его отменить.
+type stopper interface {
+	Stop() bool
+}
+
+// Таймеры создаются через переменную `afterFunc`, а не напрямую
+// через `time.AfterFunc`. Подменив её поддельным таймером, тест
+// может "запускать" отложенный вызов сам, без реального ожидания.
+var afterFunc = func(d time.Duration, f func()) stopper {
+	return time.AfterFunc(d, f)
+}
+
+// Debounced возвращает функцию, каждый вызов которой откладывает
+// выполнение `fn` на `d`, отменяя предыдущий отложенный вызов.
+// В итоге `fn` выполнится один раз — через `d` после последнего
+// вызова в серии. Возвращённую функцию можно вызывать из разных
+// горутин: замыкание защищает свой таймер мьютексом.
+func Debounced(d time.Duration, fn func()) func() {
+	var mu sync.Mutex
+	var timer stopper
+
+	return func() {
+		mu.Lock()
+		defer mu.Unlock()
+		if timer != nil {
+			timer.Stop()
+		}
+		timer = afterFunc(d, fn)
+	}
+}
+
+func main() {
+	var mu sync.Mutex
+	calls := 0
+	save := Debounced(50*time.Millisecond, func() {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+		fmt.Println("saved")
+	})
+
+	// Пять быстрых вызовов подряд — пауза между ними меньше `d`,
+	// поэтому каждый следующий отменяет предыдущий.
+	for i := 0; i < 5; i++ {
+		save()
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// Ждём, пока пройдёт период тишины.
+	time.Sleep(100 * time.Millisecond)
+	mu.Lock()
+	fmt.Println("calls:", calls)
+	mu.Unlock()
+}
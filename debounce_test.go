@@ -0,0 +1,89 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+// fakeTimer запоминает отложенную функцию вместо того, чтобы
+// запускать её по времени; тест сам решает, когда она «сработает».
+type fakeTimer struct {
+	d       time.Duration
+	f       func()
+	stopped bool
+}
+
+func (t *fakeTimer) Stop() bool {
+	wasActive := !t.stopped
+	t.stopped = true
+	return wasActive
+}
+
+// useFakeTimers подменяет `afterFunc` на время теста и возвращает
+// указатель на срез всех созданных таймеров.
+func useFakeTimers(t *testing.T) *[]*fakeTimer {
+	var timers []*fakeTimer
+	orig := afterFunc
+	afterFunc = func(d time.Duration, f func()) stopper {
+		ft := &fakeTimer{d: d, f: f}
+		timers = append(timers, ft)
+		return ft
+	}
+	t.Cleanup(func() { afterFunc = orig })
+	return &timers
+}
+
+// fireActive запускает все таймеры, которые не были отменены.
+func fireActive(timers []*fakeTimer) {
+	for _, ft := range timers {
+		if !ft.stopped {
+			ft.f()
+		}
+	}
+}
+
+func TestDebouncedBurstCallsOnce(t *testing.T) {
+	timers := useFakeTimers(t)
+
+	calls := 0
+	save := Debounced(50*time.Millisecond, func() { calls++ })
+	for i := 0; i < 5; i++ {
+		save()
+	}
+
+	if got := len(*timers); got != 5 {
+		t.Fatalf("created %d timers, want 5", got)
+	}
+	for i, ft := range (*timers)[:4] {
+		if !ft.stopped {
+			t.Errorf("timer %d was not stopped", i)
+		}
+	}
+	if d := (*timers)[4].d; d != 50*time.Millisecond {
+		t.Errorf("delay = %v, want 50ms", d)
+	}
+
+	fireActive(*timers)
+	if calls != 1 {
+		t.Errorf("fn called %d times, want 1", calls)
+	}
+}
+
+func TestDebouncedSeparateBursts(t *testing.T) {
+	timers := useFakeTimers(t)
+
+	calls := 0
+	save := Debounced(time.Second, func() { calls++ })
+
+	// Между сериями таймер успевает сработать, поэтому каждая
+	// серия даёт свой вызов.
+	for burst := 1; burst <= 2; burst++ {
+		save()
+		save()
+		fireActive(*timers)
+		*timers = nil
+		if calls != burst {
+			t.Fatalf("after burst %d: fn called %d times, want %d", burst, calls, burst)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+// Оператор `==` не работает для карт и срезов, а
+// [reflect.DeepEqual](https://pkg.go.dev/reflect#DeepEqual)
+// универсален, но медленен. Для типичных вложенных структур
+// из `map[string]any` и `[]any` (например, после разбора JSON)
+// достаточно простой рекурсивной проверки.
+
+package main
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// DeepEqualAny сравнивает `a` и `b` структурно: карты равны,
+// если у них одинаковые ключи и равные значения, срезы — если
+// у них одинаковая длина и равные элементы по порядку.
+// Остальные значения сравниваются через `==`, а к
+// `reflect.DeepEqual` мы обращаемся только для несравнимых
+// типов, которые не разбираем сами (например, `[]int`).
+func DeepEqualAny(a, b any) bool {
+	switch a := a.(type) {
+	case map[string]any:
+		b, ok := b.(map[string]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for k, av := range a {
+			// Двузначная форма чтения отличает отсутствующий
+			// ключ от ключа со значением `nil`.
+			bv, ok := b[k]
+			if !ok || !DeepEqualAny(av, bv) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		b, ok := b.([]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !DeepEqualAny(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	}
+
+	// Сравнение интерфейсов через `==` паникует, если динамическое
+	// значение несравнимо, поэтому сначала проверяем это. Проверять
+	// нужно значение, а не тип: структура с полем типа `any` —
+	// сравнимый тип, но если в поле лежит срез, `==` паникует.
+	if a == nil || b == nil {
+		return a == b
+	}
+	if reflect.ValueOf(a).Comparable() && reflect.ValueOf(b).Comparable() {
+		return a == b
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func main() {
+	a := map[string]any{
+		"name": "gopher",
+		"tags": []any{"go", map[string]any{"level": 2}},
+	}
+	b := map[string]any{
+		"name": "gopher",
+		"tags": []any{"go", map[string]any{"level": 2}},
+	}
+	fmt.Println("equal:", DeepEqualAny(a, b))
+
+	// Отличается значение глубоко внутри.
+	b["tags"].([]any)[1].(map[string]any)["level"] = 3
+	fmt.Println("different value:", DeepEqualAny(a, b))
+
+	// Отличается форма: срез вместо карты и срезы разной длины.
+	fmt.Println("different shape:", DeepEqualAny(a, []any{"gopher"}))
+	fmt.Println("different length:", DeepEqualAny([]any{1, 2}, []any{1, 2, 3}))
+
+	// Значения разных типов не равны, даже если "выглядят" одинаково.
+	fmt.Println("int vs float:", DeepEqualAny(1, 1.0))
+
+	// Тип структуры сравним, но значение в поле — нет.
+	type box struct{ X any }
+	fmt.Println("struct with slice:", DeepEqualAny(box{[]int{1}}, box{[]int{1}}))
+}
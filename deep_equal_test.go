@@ -0,0 +1,45 @@
+package main
+
+import "testing"
+
+type box struct{ X any }
+
+func TestDeepEqualAny(t *testing.T) {
+	nested := func(level int) map[string]any {
+		return map[string]any{
+			"name": "gopher",
+			"tags": []any{"go", map[string]any{"level": level}},
+		}
+	}
+	var tests = []struct {
+		name string
+		a, b any
+		want bool
+	}{
+		{"equal nested", nested(2), nested(2), true},
+		{"different deep value", nested(2), nested(3), false},
+		{"map vs slice", nested(2), []any{"gopher"}, false},
+		{"different length", []any{1, 2}, []any{1, 2, 3}, false},
+		{"different order", []any{1, 2}, []any{2, 1}, false},
+		{"missing key vs nil", map[string]any{"a": nil}, map[string]any{"b": nil}, false},
+		{"nil value", map[string]any{"a": nil}, map[string]any{"a": nil}, true},
+		{"empty map vs empty slice", map[string]any{}, []any{}, false},
+		{"int vs float", 1, 1.0, false},
+		{"nil vs nil", nil, nil, true},
+		{"nil vs value", nil, 0, false},
+		{"uncomparable type", []int{1}, []int{1}, true},
+		{"struct with slice", box{[]int{1}}, box{[]int{1}}, true},
+		{"struct with different slice", box{[]int{1}}, box{[]int{2}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DeepEqualAny(tt.a, tt.b); got != tt.want {
+				t.Errorf("DeepEqualAny(%v, %v) = %v; want %v", tt.a, tt.b, got, tt.want)
+			}
+			// Сравнение симметрично.
+			if got := DeepEqualAny(tt.b, tt.a); got != tt.want {
+				t.Errorf("DeepEqualAny(%v, %v) = %v; want %v", tt.b, tt.a, got, tt.want)
+			}
+		})
+	}
+}
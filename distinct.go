@@ -0,0 +1,58 @@
+// Итератор может фильтровать другой итератор. Здесь мы
+// пропускаем повторы: каждое значение выдаётся только при
+// первом появлении, а уже виденные запоминаются в множестве.
+
+package main
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+)
+
+// Distinct возвращает итератор по уникальным значениям `seq`
+// в порядке их первого появления. Множество растёт вместе с
+// числом разных значений, но сама последовательность может
+// быть бесконечной: `break` в цикле `range` останавливает и
+// `Distinct`, и исходный итератор.
+func Distinct[T comparable](seq iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		seen := make(map[T]struct{})
+		for v := range seq {
+			if _, ok := seen[v]; ok {
+				continue
+			}
+			seen[v] = struct{}{}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Бесконечная последовательность остатков от деления на 4:
+// 0, 1, 2, 3, 0, 1, ...
+func mod4() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			if !yield(i % 4) {
+				return
+			}
+		}
+	}
+}
+
+func main() {
+	words := []string{"a", "b", "a", "c", "b", "a", "d"}
+	fmt.Println(slices.Collect(Distinct(slices.Values(words))))
+
+	// У `mod4` всего четыре разных значения, поэтому после
+	// четвёртого остановиться нужно самим — иначе `Distinct`
+	// будет бесконечно ждать следующего нового значения.
+	for v := range Distinct(mod4()) {
+		fmt.Println(v)
+		if v == 3 {
+			break
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDistinct(t *testing.T) {
+	var tests = []struct {
+		in, want []string
+	}{
+		{[]string{"a", "b", "a", "c", "b", "a", "d"}, []string{"a", "b", "c", "d"}},
+		{[]string{"x", "x", "x"}, []string{"x"}},
+		{nil, nil},
+	}
+	for _, tt := range tests {
+		got := slices.Collect(Distinct(slices.Values(tt.in)))
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("Distinct(%v) = %v; want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestDistinctInfinite(t *testing.T) {
+	// break останавливает и Distinct, и бесконечный mod4.
+	var got []int
+	for v := range Distinct(mod4()) {
+		got = append(got, v)
+		if v == 3 {
+			break
+		}
+	}
+	if want := []int{0, 1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("Distinct(mod4()) = %v; want %v", got, want)
+	}
+}
+
+func TestDistinctFreshState(t *testing.T) {
+	// Каждый обход начинается с пустого множества.
+	seq := Distinct(slices.Values([]int{1, 1, 2}))
+	for i := 0; i < 2; i++ {
+		if got := slices.Collect(seq); !slices.Equal(got, []int{1, 2}) {
+			t.Errorf("pass %d: Distinct() = %v; want [1 2]", i, got)
+		}
+	}
+}
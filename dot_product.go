@@ -0,0 +1,49 @@
+// Скалярное произведение векторов — сумма попарных
+// произведений их координат. Сделаем функцию обобщённой,
+// чтобы она работала и с целыми, и с вещественными числами.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Number — ограничение типа для чисел, с которыми работают
+// `+` и `*`. Тильда `~` допускает и типы, объявленные поверх
+// встроенных, например `type Meters float64`.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+var ErrLengthMismatch = errors.New("vectors have different lengths")
+
+// Dot возвращает скалярное произведение `a` и `b`. Векторы
+// разной длины перемножать нельзя: возвращается
+// `ErrLengthMismatch`. Для двух пустых векторов результат 0.
+func Dot[T Number](a, b []T) (T, error) {
+	if len(a) != len(b) {
+		return 0, fmt.Errorf("dot %d×%d: %w", len(a), len(b), ErrLengthMismatch)
+	}
+	var sum T
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum, nil
+}
+
+func main() {
+	fmt.Println(Dot([]int{1, 2, 3}, []int{4, 5, 6}))
+
+	// Скалярное произведение вектора на себя — квадрат его длины.
+	v := []float64{3, 4}
+	fmt.Println(Dot(v, v))
+
+	// Для перпендикулярных (ортогональных) векторов результат 0.
+	fmt.Println(Dot([]float64{1, 0}, []float64{0, 1}))
+
+	_, err := Dot([]int{1, 2}, []int{1, 2, 3})
+	fmt.Println(err, errors.Is(err, ErrLengthMismatch))
+}
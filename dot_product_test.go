@@ -0,0 +1,47 @@
+package main
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDot(t *testing.T) {
+	var tests = []struct {
+		a, b []int
+		want int
+	}{
+		{[]int{1, 2, 3}, []int{4, 5, 6}, 32},
+		{[]int{1, 0}, []int{0, 1}, 0},
+		{[]int{-1, 2}, []int{3, 4}, 5},
+		{nil, nil, 0},
+	}
+	for _, tt := range tests {
+		got, err := Dot(tt.a, tt.b)
+		if err != nil || got != tt.want {
+			t.Errorf("Dot(%v, %v) = %d, %v; want %d, nil", tt.a, tt.b, got, err, tt.want)
+		}
+	}
+}
+
+type meters float64
+
+func TestDotFloatAndNamedTypes(t *testing.T) {
+	v := []float64{3, 4}
+	if got, err := Dot(v, v); err != nil || got != 25 {
+		t.Errorf("Dot(%v, %v) = %v, %v; want 25, nil", v, v, got, err)
+	}
+	// Тильда в ограничении допускает именованные типы.
+	m := []meters{1.5, 2}
+	if got, err := Dot(m, m); err != nil || got != 6.25 {
+		t.Errorf("Dot(%v, %v) = %v, %v; want 6.25, nil", m, m, got, err)
+	}
+}
+
+func TestDotLengthMismatch(t *testing.T) {
+	if _, err := Dot([]int{1, 2}, []int{1, 2, 3}); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("Dot() error = %v; want %v", err, ErrLengthMismatch)
+	}
+	if _, err := Dot(nil, []int{1}); !errors.Is(err, ErrLengthMismatch) {
+		t.Errorf("Dot() error = %v; want %v", err, ErrLengthMismatch)
+	}
+}
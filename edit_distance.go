@@ -0,0 +1,80 @@
+// [_Расстояние Левенштейна_](https://en.wikipedia.org/wiki/Levenshtein_distance)
+// — минимальное число вставок, удалений и замен символов, чтобы
+// превратить одну строку в другую. Это классический пример
+// _динамического программирования_: ответ для целых строк
+// собирается из ответов для их префиксов.
+
+package main
+
+import "fmt"
+
+// EditDistance возвращает расстояние Левенштейна между `a` и `b`.
+// Строки сравниваются по рунам, как в примере о строках и рунах,
+// поэтому замена одной кириллической буквы стоит 1, а не 2.
+func EditDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	// `dp[i][j]` — расстояние между первыми `i` рунами `a`
+	// и первыми `j` рунами `b`.
+	dp := make([][]int, len(ra)+1)
+	for i := range dp {
+		dp[i] = make([]int, len(rb)+1)
+		// Превратить префикс длины `i` в пустую строку — `i` удалений.
+		dp[i][0] = i
+	}
+	for j := range dp[0] {
+		// А пустую строку в префикс длины `j` — `j` вставок.
+		dp[0][j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			dp[i][j] = min(
+				dp[i-1][j]+1,      // удаление
+				dp[i][j-1]+1,      // вставка
+				dp[i-1][j-1]+cost, // замена (или совпадение)
+			)
+		}
+	}
+	return dp[len(ra)][len(rb)]
+}
+
+// BestMatch возвращает кандидата с наименьшим расстоянием
+// редактирования до `query` и само это расстояние. При равных
+// расстояниях побеждает кандидат, стоящий в срезе раньше. Для
+// пустого списка кандидатов возвращается `""` и `-1`.
+func BestMatch(query string, candidates []string) (string, int) {
+	best, bestDist := "", -1
+	for _, c := range candidates {
+		// Строгое `<` сохраняет первого из равных кандидатов.
+		if d := EditDistance(query, c); bestDist == -1 || d < bestDist {
+			best, bestDist = c, d
+		}
+	}
+	return best, bestDist
+}
+
+func main() {
+	pairs := [][2]string{
+		{"gopher", "gopher"},
+		{"gopher", "gophers"},
+		{"gopher", "goper"},
+		{"gopher", "gofer"},
+		{"kitten", "sitting"},
+		{"кот", "кит"},
+	}
+	for _, p := range pairs {
+		fmt.Printf("%s -> %s: %d\n", p[0], p[1], EditDistance(p[0], p[1]))
+	}
+
+	// Подсказка для опечатки в команде, как в `git`.
+	commands := []string{"build", "run", "test", "vet", "fmt"}
+	fmt.Println(BestMatch("tset", commands))
+
+	// `bat` и `cat` одинаково далеки от `hat`: выигрывает первый.
+	fmt.Println(BestMatch("hat", []string{"bat", "cat"}))
+}
@@ -0,0 +1,55 @@
+package main
+
+import "testing"
+
+func TestEditDistance(t *testing.T) {
+	var tests = []struct {
+		a, b string
+		want int
+	}{
+		{"gopher", "gopher", 0},
+		{"gopher", "gophers", 1},
+		{"gopher", "goper", 1},
+		{"gopher", "gofer", 2},
+		{"kitten", "sitting", 3},
+		{"", "", 0},
+		{"", "abc", 3},
+		{"abc", "", 3},
+		{"flaw", "lawn", 2},
+		// Кириллица сравнивается по рунам, а не по байтам.
+		{"кот", "кит", 1},
+		{"кот", "", 3},
+	}
+	for _, tt := range tests {
+		if got := EditDistance(tt.a, tt.b); got != tt.want {
+			t.Errorf("EditDistance(%q, %q) = %d; want %d", tt.a, tt.b, got, tt.want)
+		}
+		// Расстояние симметрично.
+		if got := EditDistance(tt.b, tt.a); got != tt.want {
+			t.Errorf("EditDistance(%q, %q) = %d; want %d", tt.b, tt.a, got, tt.want)
+		}
+	}
+}
+
+func TestBestMatch(t *testing.T) {
+	var tests = []struct {
+		query      string
+		candidates []string
+		want       string
+		wantDist   int
+	}{
+		{"tset", []string{"build", "run", "test", "vet", "fmt"}, "test", 2},
+		{"run", []string{"build", "run", "test"}, "run", 0},
+		// При равных расстояниях побеждает первый кандидат.
+		{"hat", []string{"bat", "cat"}, "bat", 1},
+		{"hat", []string{"cat", "bat"}, "cat", 1},
+		{"go", nil, "", -1},
+	}
+	for _, tt := range tests {
+		got, dist := BestMatch(tt.query, tt.candidates)
+		if got != tt.want || dist != tt.wantDist {
+			t.Errorf("BestMatch(%q, %q) = %q, %d; want %q, %d",
+				tt.query, tt.candidates, got, dist, tt.want, tt.wantDist)
+		}
+	}
+}
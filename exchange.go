@@ -0,0 +1,54 @@
+// Две горутины хотят обменяться значениями по каналам: каждая
+// отправляет своё и получает чужое. Наивный порядок «сначала
+// отправить, потом получить» на небуферизованных каналах
+// приводит к взаимной блокировке: обе стоят на отправке и ждут
+// получателя, которого нет. Решение — `select`, который ждёт
+// отправку и получение одновременно.
+
+package main
+
+import "fmt"
+
+// Exchange запускает две горутины: первая отправляет `va` в `a`
+// и получает из `b`, вторая отправляет `vb` в `b` и получает из
+// `a`. Возвращаются значения, полученные первой и второй
+// горутиной, то есть `vb` и `va`. Каналы могут быть
+// небуферизованными и не должны совпадать.
+func Exchange[T any](a, b chan T, va, vb T) (T, T) {
+	gotA := make(chan T)
+	gotB := make(chan T)
+	go func() { gotA <- swap(a, b, va) }()
+	go func() { gotB <- swap(b, a, vb) }()
+	return <-gotA, <-gotB
+}
+
+// swap отправляет `v` в `out` и получает значение из `in` в том
+// порядке, в котором операции становятся возможны. Завершённую
+// операцию отключаем, присваивая каналу `nil`: операции с
+// `nil`-каналом никогда не готовы, и `select` их пропускает.
+func swap[T any](out, in chan T, v T) T {
+	var got T
+	for out != nil || in != nil {
+		select {
+		case out <- v:
+			out = nil
+		case got = <-in:
+			in = nil
+		}
+	}
+	return got
+}
+
+func main() {
+	a := make(chan string)
+	b := make(chan string)
+
+	fromB, fromA := Exchange(a, b, "ping", "pong")
+	fmt.Println("first got:", fromB)
+	fmt.Println("second got:", fromA)
+
+	// Каналы можно переиспользовать: после обмена в них
+	// ничего не осталось.
+	fromB, fromA = Exchange(a, b, "tick", "tock")
+	fmt.Println(fromB, fromA)
+}
@@ -0,0 +1,43 @@
+package main
+
+import "testing"
+
+func TestExchangeSwapsValues(t *testing.T) {
+	a := make(chan string)
+	b := make(chan string)
+
+	gotA, gotB := Exchange(a, b, "ping", "pong")
+	if gotA != "pong" || gotB != "ping" {
+		t.Errorf("Exchange = (%q, %q), want (\"pong\", \"ping\")", gotA, gotB)
+	}
+}
+
+// Обмен повторяется много раз на одних и тех же небуферизованных
+// каналах: взаимная блокировка или перепутанные значения
+// проявились бы здесь, а `-race` проверяет доступ к данным.
+func TestExchangeRepeated(t *testing.T) {
+	a := make(chan int)
+	b := make(chan int)
+
+	for i := 0; i < 1000; i++ {
+		gotA, gotB := Exchange(a, b, i, -i)
+		if gotA != -i || gotB != i {
+			t.Fatalf("round %d: Exchange = (%d, %d), want (%d, %d)", i, gotA, gotB, -i, i)
+		}
+	}
+}
+
+// С буферизованными каналами отправки не ждут получателя, но
+// каждая сторона всё равно получает значение другой.
+func TestExchangeBuffered(t *testing.T) {
+	a := make(chan int, 1)
+	b := make(chan int, 1)
+
+	gotA, gotB := Exchange(a, b, 1, 2)
+	if gotA != 2 || gotB != 1 {
+		t.Errorf("Exchange = (%d, %d), want (2, 1)", gotA, gotB)
+	}
+	if len(a) != 0 || len(b) != 0 {
+		t.Errorf("channels not drained: len(a)=%d, len(b)=%d", len(a), len(b))
+	}
+}
@@ -0,0 +1,270 @@
+// [_Рекурсивный спуск_](https://en.wikipedia.org/wiki/Recursive_descent_parser)
+// — простой способ разобрать выражение по его грамматике: каждому
+// правилу грамматики соответствует функция, а вложенность
+// правил выражается рекурсивными вызовами. Здесь мы вычисляем
+// арифметические выражения с `+ - * /` и скобками.
+//
+// Работа разделена на два этапа: _лексер_ превращает строку
+// в срез токенов, а парсер вычисляет выражение по токенам.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"unicode"
+)
+
+// Вид токена — перечисление, как `ServerState` в примере о перечислениях.
+type TokenKind int
+
+const (
+	TokenNumber TokenKind = iota
+	TokenOperator
+	TokenLParen
+	TokenRParen
+)
+
+var tokenKindName = map[TokenKind]string{
+	TokenNumber:   "number",
+	TokenOperator: "operator",
+	TokenLParen:   "lparen",
+	TokenRParen:   "rparen",
+}
+
+func (k TokenKind) String() string {
+	return tokenKindName[k]
+}
+
+// `Token` хранит вид, исходный текст и позицию токена
+// (в рунах) для сообщений об ошибках.
+type Token struct {
+	Kind TokenKind
+	Text string
+	Pos  int
+}
+
+// isDigit принимает только ASCII-цифры: `unicode.IsDigit` пропустил
+// бы и, например, арабско-индийскую `٣`, которую не понимает
+// `strconv.ParseFloat`.
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+// Tokenize разбивает строку на токены, пропуская пробелы.
+// Строка перебирается по рунам, поэтому позиция в ошибке
+// указывает на символ, а не на байт, даже для многобайтовых рун.
+// Число — это цифры с не более чем одной точкой, причём хотя бы
+// одна цифра обязательна: `1.2.3` и одинокая `.` — ошибки.
+func Tokenize(s string) ([]Token, error) {
+	runes := []rune(s)
+	var tokens []Token
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case isDigit(r) || r == '.':
+			// Число продолжается, пока идут цифры и точки.
+			start := i
+			dots, digits := 0, 0
+			for i < len(runes) && (isDigit(runes[i]) || runes[i] == '.') {
+				if runes[i] == '.' {
+					dots++
+				} else {
+					digits++
+				}
+				i++
+			}
+			if dots > 1 || digits == 0 {
+				return nil, fmt.Errorf("invalid number %q at position %d",
+					string(runes[start:i]), start)
+			}
+			tokens = append(tokens, Token{TokenNumber, string(runes[start:i]), start})
+		case r == '+' || r == '-' || r == '*' || r == '/':
+			tokens = append(tokens, Token{TokenOperator, string(r), i})
+			i++
+		case r == '(':
+			tokens = append(tokens, Token{TokenLParen, string(r), i})
+			i++
+		case r == ')':
+			tokens = append(tokens, Token{TokenRParen, string(r), i})
+			i++
+		default:
+			return nil, fmt.Errorf("invalid character %q at position %d", r, i)
+		}
+	}
+	return tokens, nil
+}
+
+// Грамматика, которую мы разбираем. Приоритет операций
+// задаётся уровнями: `*` и `/` связываются сильнее, чем `+` и `-`,
+// потому что `term` разбирается "глубже", чем `expr`.
+//
+//	expr   = term { ("+" | "-") term }
+//	term   = factor { ("*" | "/") factor }
+//	factor = number | "-" factor | "(" expr ")"
+
+var ErrDivisionByZero = errors.New("division by zero")
+
+// parser хранит токены выражения и текущую позицию в них.
+type parser struct {
+	tokens []Token
+	pos    int
+}
+
+// peek возвращает текущий токен и `false`, если токены закончились.
+func (p *parser) peek() (Token, bool) {
+	if p.pos == len(p.tokens) {
+		return Token{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+// peekOperator возвращает текущий оператор, если он один из `ops`.
+func (p *parser) peekOperator(ops ...string) (string, bool) {
+	t, ok := p.peek()
+	if !ok || t.Kind != TokenOperator {
+		return "", false
+	}
+	for _, op := range ops {
+		if t.Text == op {
+			return op, true
+		}
+	}
+	return "", false
+}
+
+func (p *parser) expr() (float64, error) {
+	left, err := p.term()
+	if err != nil {
+		return 0, err
+	}
+	for {
+		op, ok := p.peekOperator("+", "-")
+		if !ok {
+			return left, nil
+		}
+		p.pos++
+		right, err := p.term()
+		if err != nil {
+			return 0, err
+		}
+		if op == "+" {
+			left += right
+		} else {
+			left -= right
+		}
+	}
+}
+
+func (p *parser) term() (float64, error) {
+	left, err := p.factor()
+	if err != nil {
+		return 0, err
+	}
+	for {
+		op, ok := p.peekOperator("*", "/")
+		if !ok {
+			return left, nil
+		}
+		p.pos++
+		right, err := p.factor()
+		if err != nil {
+			return 0, err
+		}
+		if op == "*" {
+			left *= right
+		} else {
+			if right == 0 {
+				return 0, ErrDivisionByZero
+			}
+			left /= right
+		}
+	}
+}
+
+func (p *parser) factor() (float64, error) {
+	t, ok := p.peek()
+	if !ok {
+		return 0, errors.New("unexpected end of expression")
+	}
+	p.pos++
+	switch {
+	case t.Kind == TokenNumber:
+		// Форму числа уже проверил `Tokenize`; здесь может
+		// остаться только выход за пределы `float64`.
+		v, err := strconv.ParseFloat(t.Text, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid number %q at position %d", t.Text, t.Pos)
+		}
+		return v, nil
+	case t.Kind == TokenOperator && t.Text == "-":
+		// Унарный минус рекурсивно применяется к следующему множителю.
+		v, err := p.factor()
+		return -v, err
+	case t.Kind == TokenLParen:
+		// Выражение в скобках снова разбирается с самого верхнего
+		// уровня — это и есть рекурсия, давшая методу название.
+		v, err := p.expr()
+		if err != nil {
+			return 0, err
+		}
+		if t, ok := p.peek(); !ok || t.Kind != TokenRParen {
+			return 0, errors.New("expected ')'")
+		}
+		p.pos++
+		return v, nil
+	default:
+		return 0, fmt.Errorf("unexpected %q at position %d", t.Text, t.Pos)
+	}
+}
+
+// Eval вычисляет арифметическое выражение. Ошибки синтаксиса
+// и деление на ноль возвращаются как значение `error`.
+func Eval(expr string) (float64, error) {
+	tokens, err := Tokenize(expr)
+	if err != nil {
+		return 0, err
+	}
+	p := &parser{tokens: tokens}
+	v, err := p.expr()
+	if err != nil {
+		return 0, err
+	}
+	// Если после полного выражения остались токены (например,
+	// лишняя скобка), выражение некорректно.
+	if t, ok := p.peek(); ok {
+		return 0, fmt.Errorf("unexpected %q at position %d", t.Text, t.Pos)
+	}
+	return v, nil
+}
+
+func main() {
+	tokens, _ := Tokenize(" 12*(3 +45) ")
+	for _, t := range tokens {
+		fmt.Printf("%-8v %q\n", t.Kind, t.Text)
+	}
+
+	// Лексер перебирает руны, поэтому многобайтовый `×`
+	// попадает в сообщение об ошибке целиком, а не байтом.
+	_, err := Tokenize("2×3")
+	fmt.Println("tokenize error:", err)
+
+	for _, e := range []string{
+		"1 + 2 * 3",
+		"(1 + 2) * 3",
+		"-(4 - 10) / 4",
+		"1 / (2 - 2)",
+		"2 * (3 + ",
+		"2 3",
+	} {
+		v, err := Eval(e)
+		if err != nil {
+			fmt.Printf("%-14s error: %v\n", e, err)
+			continue
+		}
+		fmt.Printf("%-14s = %v\n", e, v)
+	}
+}
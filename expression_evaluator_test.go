@@ -0,0 +1,119 @@
+package main
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// texts возвращает текст каждого токена — так ожидаемый
+// результат удобно записывать одной строкой.
+func texts(tokens []Token) []string {
+	var out []string
+	for _, t := range tokens {
+		out = append(out, t.Text)
+	}
+	return out
+}
+
+func TestTokenizeWhitespace(t *testing.T) {
+	tokens, err := Tokenize(" 1 +\t2 \n")
+	if err != nil {
+		t.Fatalf("Tokenize: %v", err)
+	}
+	if got, want := texts(tokens), []string{"1", "+", "2"}; !slices.Equal(got, want) {
+		t.Errorf("tokens = %q; want %q", got, want)
+	}
+	// Позиции считаются в исходной строке, вместе с пробелами.
+	if tokens[2].Pos != 5 {
+		t.Errorf("position of %q = %d; want 5", tokens[2].Text, tokens[2].Pos)
+	}
+}
+
+func TestTokenizeMultiDigit(t *testing.T) {
+	tokens, err := Tokenize("12*(345-6.75)")
+	if err != nil {
+		t.Fatalf("Tokenize: %v", err)
+	}
+	want := []Token{
+		{TokenNumber, "12", 0},
+		{TokenOperator, "*", 2},
+		{TokenLParen, "(", 3},
+		{TokenNumber, "345", 4},
+		{TokenOperator, "-", 7},
+		{TokenNumber, "6.75", 8},
+		{TokenRParen, ")", 12},
+	}
+	if !slices.Equal(tokens, want) {
+		t.Errorf("tokens = %v; want %v", tokens, want)
+	}
+}
+
+func TestTokenizeErrors(t *testing.T) {
+	var tests = []struct {
+		in, want string
+	}{
+		{"2×3", `invalid character '×' at position 1`},
+		{"1 & 2", `invalid character '&' at position 2`},
+		{"٣+1", `invalid character '٣' at position 0`},
+		{".", `invalid number "." at position 0`},
+		{"1 + 1.2.3", `invalid number "1.2.3" at position 4`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			_, err := Tokenize(tt.in)
+			if err == nil || err.Error() != tt.want {
+				t.Errorf("Tokenize(%q) error = %v; want %q", tt.in, err, tt.want)
+			}
+			// Eval сообщает ту же ошибку, а не ошибку `strconv`.
+			if _, err := Eval(tt.in); err == nil || strings.Contains(err.Error(), "strconv") {
+				t.Errorf("Eval(%q) error = %v", tt.in, err)
+			}
+		})
+	}
+}
+
+func TestEval(t *testing.T) {
+	var tests = []struct {
+		in   string
+		want float64
+	}{
+		{"1 + 2 * 3", 7},
+		{"2 * 3 + 1", 7},
+		{"10 - 4 - 3", 3},
+		{"8 / 4 / 2", 1},
+		{"(1 + 2) * 3", 9},
+		{"((2))", 2},
+		{"-(4 - 10) / 4", 1.5},
+		{"--3", 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := Eval(tt.in)
+			if err != nil {
+				t.Fatalf("Eval(%q): %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Errorf("Eval(%q) = %v; want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvalDivisionByZero(t *testing.T) {
+	_, err := Eval("1 / (2 - 2)")
+	if !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("error = %v; want %v", err, ErrDivisionByZero)
+	}
+}
+
+func TestEvalSyntaxErrors(t *testing.T) {
+	for _, in := range []string{"", "2 * (3 + ", "(1 + 2", "1 + 2)", "2 3", "* 2"} {
+		if v, err := Eval(in); err == nil {
+			t.Errorf("Eval(%q) = %v; want an error", in, v)
+		}
+	}
+}
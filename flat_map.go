@@ -0,0 +1,65 @@
+// `FlatMap` превращает каждый элемент последовательности в
+// собственную подпоследовательность и склеивает их в одну.
+// Так, например, из списка директорий получается список
+// всех файлов в них.
+
+package main
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+)
+
+// FlatMap возвращает итератор, который для каждого элемента `seq`
+// вызывает `f` и по порядку выдаёт все значения полученной
+// подпоследовательности. Если потребитель остановился посреди
+// подпоследовательности, останавливаются и она, и `seq`:
+// следующие элементы не запрашиваются и `f` больше не вызывается.
+func FlatMap[T, U any](seq iter.Seq[T], f func(T) iter.Seq[U]) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for v := range seq {
+			for u := range f(v) {
+				if !yield(u) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// upTo возвращает итератор по числам от 1 до `n`.
+func upTo(n int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := 1; i <= n; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func main() {
+	// Каждое число разворачивается в диапазон 1..n.
+	nums := slices.Values([]int{1, 2, 3})
+	fmt.Println(slices.Collect(FlatMap(nums, upTo)))
+
+	// Для нуля подпоследовательность пустая и просто
+	// пропускается.
+	fmt.Println(slices.Collect(FlatMap(slices.Values([]int{2, 0, 1}), upTo)))
+
+	// Досрочная остановка внутри второй подпоследовательности:
+	// `f` вызывается только для тех элементов, до которых дошли.
+	calls := 0
+	counted := func(n int) iter.Seq[int] {
+		calls++
+		return upTo(n)
+	}
+	for v := range FlatMap(slices.Values([]int{2, 3, 4, 5}), counted) {
+		fmt.Print(v, " ")
+		if v == 3 {
+			break
+		}
+	}
+	fmt.Println("\ncalls:", calls)
+}
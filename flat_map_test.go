@@ -0,0 +1,47 @@
+package main
+
+import (
+	"iter"
+	"slices"
+	"testing"
+)
+
+func TestFlatMap(t *testing.T) {
+	var tests = []struct {
+		in, want []int
+	}{
+		{[]int{1, 2, 3}, []int{1, 1, 2, 1, 2, 3}},
+		// Пустые подпоследовательности пропускаются.
+		{[]int{2, 0, 1}, []int{1, 2, 1}},
+		{[]int{0, 0}, nil},
+		{nil, nil},
+	}
+	for _, tt := range tests {
+		got := slices.Collect(FlatMap(slices.Values(tt.in), upTo))
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("FlatMap(%v, upTo) = %v; want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestFlatMapEarlyStop(t *testing.T) {
+	calls := 0
+	counted := func(n int) iter.Seq[int] {
+		calls++
+		return upTo(n)
+	}
+	var got []int
+	for v := range FlatMap(slices.Values([]int{2, 3, 4, 5}), counted) {
+		got = append(got, v)
+		if v == 3 {
+			break
+		}
+	}
+	if want := []int{1, 2, 1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("FlatMap() = %v; want %v", got, want)
+	}
+	// f вызвана только для 2 и 3.
+	if calls != 2 {
+		t.Errorf("f called %d times; want 2", calls)
+	}
+}
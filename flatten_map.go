@@ -0,0 +1,71 @@
+// Вложенные карты часто удобнее обрабатывать в "плоском" виде:
+// например, конфигурацию `{"db": {"host": ...}}` можно
+// представить как один уровень с ключом `db.host`.
+
+package main
+
+import "fmt"
+
+// FlattenMap возвращает новую одноуровневую карту, в которой
+// ключи вложенных карт соединены точкой: `a.b.c`. Исходная
+// карта не изменяется.
+//
+// Рекурсия идёт только по значениям типа `map[string]any`.
+// Срезы считаются листовыми значениями и копируются в
+// результат как есть, без разворачивания в ключи вида `a.0`.
+// Пустая вложенная карта тоже считается листом и попадает в
+// результат как есть: иначе ключ бесследно исчез бы.
+//
+// Если ключи сами содержат точки, два разных пути могут дать
+// один плоский ключ: `{"a.b": 1}` и `{"a": {"b": 2}}`. Какое
+// значение победит, зависело бы от порядка обхода карты, поэтому,
+// как и `Transpose` для рваной матрицы, `FlattenMap` в этом случае
+// паникует с именем ключа — такие данные нельзя сплющить без потерь.
+func FlattenMap(m map[string]any) map[string]any {
+	out := make(map[string]any)
+	flatten(out, "", m)
+	return out
+}
+
+// flatten добавляет в `out` все листья `m`, дописывая
+// их ключи к префиксу `prefix`.
+func flatten(out map[string]any, prefix string, m map[string]any) {
+	for k, v := range m {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		if nested, ok := v.(map[string]any); ok && len(nested) > 0 {
+			flatten(out, key, nested)
+			continue
+		}
+		if _, dup := out[key]; dup {
+			panic(fmt.Sprintf("FlattenMap: duplicate key %q", key))
+		}
+		out[key] = v
+	}
+}
+
+func main() {
+	m := map[string]any{
+		"name": "app",
+		"db": map[string]any{
+			"host": "localhost",
+			"pool": map[string]any{
+				"min": 1,
+				"max": 10,
+			},
+		},
+		"ports": []any{80, 443},
+		"tags":  map[string]any{},
+	}
+
+	// `fmt.Println` печатает карты с отсортированными ключами.
+	fmt.Println(FlattenMap(m))
+
+	// Совпадающие плоские ключи приводят к панике.
+	defer func() {
+		fmt.Println("recovered:", recover())
+	}()
+	FlattenMap(map[string]any{"a.b": 1, "a": map[string]any{"b": 2}})
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFlattenMapOneLevel(t *testing.T) {
+	got := FlattenMap(map[string]any{
+		"name": "app",
+		"db":   map[string]any{"host": "localhost", "port": 5432},
+	})
+	want := map[string]any{
+		"name":    "app",
+		"db.host": "localhost",
+		"db.port": 5432,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FlattenMap = %v; want %v", got, want)
+	}
+}
+
+func TestFlattenMapTwoLevels(t *testing.T) {
+	got := FlattenMap(map[string]any{
+		"db": map[string]any{
+			"pool": map[string]any{"min": 1, "max": 10},
+		},
+		"ports": []any{80, 443},
+	})
+	want := map[string]any{
+		"db.pool.min": 1,
+		"db.pool.max": 10,
+		"ports":       []any{80, 443},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FlattenMap = %v; want %v", got, want)
+	}
+}
+
+func TestFlattenMapEmptyNested(t *testing.T) {
+	got := FlattenMap(map[string]any{"a": map[string]any{}, "b": 1})
+	want := map[string]any{"a": map[string]any{}, "b": 1}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FlattenMap = %v; want %v", got, want)
+	}
+}
+
+func TestFlattenMapCollisionPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("FlattenMap did not panic on a duplicate key")
+		}
+	}()
+	FlattenMap(map[string]any{"a.b": 1, "a": map[string]any{"b": 2}})
+}
@@ -0,0 +1,63 @@
+// Функция `next`, которая по числу возвращает следующее, задаёт
+// «функциональный граф»: из каждой вершины выходит ровно одна
+// стрелка. Цепочка start → next(start) → ... похожа на связный
+// список, и найти в ней цикл можно алгоритмом Флойда («черепаха
+// и заяц») — без дополнительной памяти на посещённые вершины.
+
+package main
+
+import "fmt"
+
+// HasFunctionalCycle сообщает, зацикливается ли цепочка,
+// начинающаяся со `start`. Отрицательное значение `next` означает
+// конец цепочки, как `nil` в конце списка; сама `start` должна
+// быть неотрицательной.
+//
+// Черепаха делает один шаг, заяц — два. Если цепочка конечна,
+// заяц первым дойдёт до конца; если в ней есть цикл, оба
+// попадут в него, и заяц догонит черепаху не более чем за
+// длину цикла шагов.
+func HasFunctionalCycle(start int, next func(int) int) bool {
+	slow, fast := start, start
+	for {
+		for range 2 {
+			if fast = next(fast); fast < 0 {
+				return false
+			}
+		}
+		slow = next(slow)
+		if slow == fast {
+			return true
+		}
+	}
+}
+
+func main() {
+	// x → x² + 1 по модулю 10: 3 → 0 → 1 → 2 → 5 → 6 → 7 → 0 → ...
+	// Цикл начинается не со `start`, а после «хвоста» из одного
+	// элемента.
+	square := func(x int) int { return (x*x + 1) % 10 }
+	fmt.Println(HasFunctionalCycle(3, square))
+
+	// Обратный отсчёт до нуля, после которого цепочка кончается.
+	countdown := func(x int) int { return x - 1 }
+	fmt.Println(HasFunctionalCycle(5, countdown))
+
+	// Гипотеза Коллатца: для проверенных чисел последовательность
+	// доходит до 1. Считаем 1 концом цепочки; иначе она ушла бы в
+	// цикл 1 → 4 → 2 → 1.
+	collatz := func(x int) int {
+		switch {
+		case x == 1:
+			return -1
+		case x%2 == 0:
+			return x / 2
+		default:
+			return 3*x + 1
+		}
+	}
+	fmt.Println(HasFunctionalCycle(27, collatz))
+
+	// Самопетля: значение указывает само на себя.
+	fmt.Println(HasFunctionalCycle(7, func(x int) int { return x }))
+}
@@ -0,0 +1,44 @@
+package main
+
+import "testing"
+
+func TestHasFunctionalCycle(t *testing.T) {
+	collatz := func(x int) int {
+		switch {
+		case x == 1:
+			return -1
+		case x%2 == 0:
+			return x / 2
+		default:
+			return 3*x + 1
+		}
+	}
+	var tests = []struct {
+		name  string
+		start int
+		next  func(int) int
+		want  bool
+	}{
+		{"cycle after tail", 3, func(x int) int { return (x*x + 1) % 10 }, true},
+		{"countdown", 5, func(x int) int { return x - 1 }, false},
+		{"collatz", 27, collatz, false},
+		{"self-loop", 7, func(x int) int { return x }, true},
+		{"ends immediately", 0, func(int) int { return -1 }, false},
+		// Цепочка нечётной длины: заяц кончается на первом из двух шагов.
+		{"odd length", 2, func(x int) int { return x - 1 }, false},
+		{"two-cycle", 0, func(x int) int { return 1 - x }, true},
+		{"long tail", 100, func(x int) int {
+			if x > 0 {
+				return x - 1
+			}
+			return 3 // 0 -> 3 -> 2 -> 1 -> 0
+		}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HasFunctionalCycle(tt.start, tt.next); got != tt.want {
+				t.Errorf("HasFunctionalCycle(%d) = %v; want %v", tt.start, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+// _Граф_ — это набор вершин, соединённых рёбрами. Используя
+// обобщения и карты, мы можем описать граф с вершинами любого
+// `сравнимого` типа и обойти его в
+// [ширину](https://en.wikipedia.org/wiki/Breadth-first_search)
+// и в [глубину](https://en.wikipedia.org/wiki/Depth-first_search),
+// а также [топологически отсортировать](https://en.wikipedia.org/wiki/Topological_sorting).
+
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+// `Graph` хранит ориентированный граф в виде списков смежности:
+// каждой вершине соответствует срез её соседей. Соседи хранятся
+// в порядке добавления рёбер, и именно в этом порядке обход
+// их посещает — так результат обхода детерминирован, чего нельзя
+// было бы добиться, итерируя по карте.
+//
+// Срез `nodes` запоминает вершины в порядке их появления,
+// чтобы и сортировка всего графа не зависела от порядка
+// итерации по карте.
+type Graph[T comparable] struct {
+	adj   map[T][]T
+	nodes []T
+}
+
+// AddEdge добавляет ребро из `a` в `b`. Карта создаётся лениво,
+// поэтому нулевое значение `Graph` готово к использованию.
+func (g *Graph[T]) AddEdge(a, b T) {
+	if g.adj == nil {
+		g.adj = make(map[T][]T)
+	}
+	// Добавляем обе вершины, даже если из `b` не выходит рёбер,
+	// чтобы граф знал обо всех своих вершинах.
+	g.addNode(a)
+	g.addNode(b)
+	g.adj[a] = append(g.adj[a], b)
+}
+
+func (g *Graph[T]) addNode(v T) {
+	if _, ok := g.adj[v]; !ok {
+		g.adj[v] = nil
+		g.nodes = append(g.nodes, v)
+	}
+}
+
+// BFS возвращает вершины, достижимые из `start`, в порядке обхода
+// в ширину. Для вершины без рёбер (или отсутствующей в графе)
+// результатом будет срез из одной `start`.
+func (g *Graph[T]) BFS(start T) []T {
+	visited := map[T]bool{start: true}
+	order := []T{}
+
+	// Срез служит очередью: берём вершину из начала
+	// и добавляем непосещённых соседей в конец.
+	queue := []T{start}
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+		order = append(order, v)
+
+		for _, n := range g.adj[v] {
+			if !visited[n] {
+				visited[n] = true
+				queue = append(queue, n)
+			}
+		}
+	}
+	return order
+}
+
+// DFS возвращает вершины, достижимые из `start`, в порядке обхода
+// в глубину. Соседи, как и в `BFS`, перебираются в порядке
+// добавления рёбер.
+func (g *Graph[T]) DFS(start T) []T {
+	visited := make(map[T]bool)
+	order := []T{}
+
+	// Рекурсивное замыкание, как `fib` в примере о рекурсии,
+	// нужно заранее объявить через `var`. Множество `visited`
+	// не даёт посетить вершину повторно и зациклиться.
+	var visit func(v T)
+	visit = func(v T) {
+		visited[v] = true
+		order = append(order, v)
+		for _, n := range g.adj[v] {
+			if !visited[n] {
+				visit(n)
+			}
+		}
+	}
+	visit(start)
+	return order
+}
+
+// Сентинельная ошибка, как в примере об ошибках: вызывающий
+// код может проверить её с помощью `errors.Is`.
+var ErrCycle = errors.New("graph has a cycle")
+
+// TopoSort возвращает вершины так, что для каждого ребра
+// `a -> b` вершина `a` идёт раньше `b`. Это возможно только
+// для ациклического графа (DAG); если в графе есть цикл,
+// возвращается `ErrCycle`.
+//
+// Здесь используется алгоритм Кана: считаем для каждой вершины
+// число входящих рёбер и по очереди извлекаем вершины, в которые
+// больше ничего не входит.
+func (g *Graph[T]) TopoSort() ([]T, error) {
+	inDegree := make(map[T]int, len(g.nodes))
+	for _, v := range g.nodes {
+		for _, n := range g.adj[v] {
+			inDegree[n]++
+		}
+	}
+
+	queue := []T{}
+	for _, v := range g.nodes {
+		if inDegree[v] == 0 {
+			queue = append(queue, v)
+		}
+	}
+
+	order := make([]T, 0, len(g.nodes))
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+		order = append(order, v)
+
+		// "Удаляем" рёбра из `v`; соседи, у которых не осталось
+		// входящих рёбер, готовы к извлечению.
+		for _, n := range g.adj[v] {
+			inDegree[n]--
+			if inDegree[n] == 0 {
+				queue = append(queue, n)
+			}
+		}
+	}
+
+	// Вершины на цикле никогда не достигают нулевой
+	// входящей степени и не попадают в результат.
+	if len(order) != len(g.nodes) {
+		return nil, ErrCycle
+	}
+	return order, nil
+}
+
+func main() {
+	var g Graph[string]
+	g.AddEdge("a", "b")
+	g.AddEdge("a", "c")
+	g.AddEdge("b", "d")
+	g.AddEdge("c", "d")
+	g.AddEdge("d", "e")
+
+	// Соседи `a` посещаются в порядке добавления рёбер: сначала `b`, затем `c`.
+	fmt.Println("bfs:", g.BFS("a"))
+
+	// Вершина без рёбер даёт обход из одной вершины.
+	fmt.Println("bfs:", g.BFS("z"))
+
+	// Обход в глубину доходит до `e` через `b` и `d`,
+	// прежде чем вернуться к `c`.
+	fmt.Println("dfs:", g.DFS("a"))
+
+	// Граф выше ацикличен, поэтому у него есть топологический порядок.
+	order, err := g.TopoSort()
+	fmt.Println("topo:", order, err)
+
+	// Ребро `e -> a` замыкает цикл, и сортировка становится невозможной.
+	g.AddEdge("e", "a")
+	if _, err := g.TopoSort(); errors.Is(err, ErrCycle) {
+		fmt.Println("topo:", err)
+	}
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// diamond строит граф a -> {b, c} -> d -> e, как в примере.
+func diamond() *Graph[string] {
+	var g Graph[string]
+	g.AddEdge("a", "b")
+	g.AddEdge("a", "c")
+	g.AddEdge("b", "d")
+	g.AddEdge("c", "d")
+	g.AddEdge("d", "e")
+	return &g
+}
+
+func TestBFSNeighbourOrder(t *testing.T) {
+	g := diamond()
+	got := g.BFS("a")
+	want := []string{"a", "b", "c", "d", "e"}
+	if !slices.Equal(got, want) {
+		t.Errorf("BFS(a) = %v; want %v", got, want)
+	}
+
+	// Соседи обходятся в порядке добавления рёбер, а не по алфавиту.
+	var r Graph[string]
+	r.AddEdge("a", "c")
+	r.AddEdge("a", "b")
+	got = r.BFS("a")
+	want = []string{"a", "c", "b"}
+	if !slices.Equal(got, want) {
+		t.Errorf("BFS(a) = %v; want %v", got, want)
+	}
+}
+
+func TestBFSIsolatedStart(t *testing.T) {
+	g := diamond()
+	// "e" есть в графе, но рёбер из неё нет; "z" в графе нет вовсе.
+	for _, start := range []string{"e", "z"} {
+		got := g.BFS(start)
+		if want := []string{start}; !slices.Equal(got, want) {
+			t.Errorf("BFS(%s) = %v; want %v", start, got, want)
+		}
+	}
+
+	var empty Graph[int]
+	if got := empty.BFS(1); !slices.Equal(got, []int{1}) {
+		t.Errorf("BFS(1) on empty graph = %v; want [1]", got)
+	}
+}
+
+func TestDFSOrder(t *testing.T) {
+	g := diamond()
+	// В глубину: до e через b и d, и только потом c.
+	got := g.DFS("a")
+	want := []string{"a", "b", "d", "e", "c"}
+	if !slices.Equal(got, want) {
+		t.Errorf("DFS(a) = %v; want %v", got, want)
+	}
+
+	if got := g.DFS("z"); !slices.Equal(got, []string{"z"}) {
+		t.Errorf("DFS(z) = %v; want [z]", got)
+	}
+}
+
+func TestDFSCycle(t *testing.T) {
+	var g Graph[int]
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 1)
+	// Цикл не приводит к повторному посещению.
+	got := g.DFS(1)
+	if want := []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("DFS(1) = %v; want %v", got, want)
+	}
+}
+
+// checkTopo проверяет, что каждое ребро графа идёт вперёд
+// по порядку и что в порядке все вершины ровно по разу.
+func checkTopo[T comparable](t *testing.T, g *Graph[T], order []T) {
+	t.Helper()
+	pos := make(map[T]int, len(order))
+	for i, v := range order {
+		pos[v] = i
+	}
+	if len(pos) != len(order) || len(order) != len(g.nodes) {
+		t.Fatalf("TopoSort() = %v; want each of %v exactly once", order, g.nodes)
+	}
+	for a, ns := range g.adj {
+		for _, b := range ns {
+			if pos[a] >= pos[b] {
+				t.Errorf("TopoSort() = %v: %v comes before %v", order, b, a)
+			}
+		}
+	}
+}
+
+func TestTopoSort(t *testing.T) {
+	g := diamond()
+	order, err := g.TopoSort()
+	if err != nil {
+		t.Fatalf("TopoSort() error = %v", err)
+	}
+	checkTopo(t, g, order)
+
+	var empty Graph[int]
+	if order, err := empty.TopoSort(); err != nil || len(order) != 0 {
+		t.Errorf("TopoSort() on empty graph = %v, %v; want [], nil", order, err)
+	}
+}
+
+func TestTopoSortCycle(t *testing.T) {
+	g := diamond()
+	g.AddEdge("e", "a")
+	order, err := g.TopoSort()
+	if !errors.Is(err, ErrCycle) || order != nil {
+		t.Errorf("TopoSort() = %v, %v; want nil, %v", order, err, ErrCycle)
+	}
+
+	// Петля — тоже цикл.
+	var loop Graph[int]
+	loop.AddEdge(1, 1)
+	if _, err := loop.TopoSort(); !errors.Is(err, ErrCycle) {
+		t.Errorf("TopoSort() with self-loop error = %v; want %v", err, ErrCycle)
+	}
+}
@@ -0,0 +1,107 @@
+// Объединим перечисления и вариативные функции в небольшой
+// _логгер с уровнями_: сообщения ниже заданного порога
+// отбрасываются, а остальные пишутся в произвольный
+// [io.Writer](https://pkg.go.dev/io#Writer). Дочерние логгеры
+// добавляют к каждому сообщению пары ключ/значение.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Уровни логирования — перечисление по образцу `ServerState`.
+// Порядок констант важен: чем больше значение, тем
+// серьёзнее сообщение.
+type LogLevel int
+
+const (
+	LevelDebug LogLevel = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+var levelName = map[LogLevel]string{
+	LevelDebug: "DEBUG",
+	LevelInfo:  "INFO",
+	LevelWarn:  "WARN",
+	LevelError: "ERROR",
+}
+
+func (l LogLevel) String() string {
+	return levelName[l]
+}
+
+// `Logger` пишет в `out` только сообщения уровня `min` и выше.
+// Приёмник — интерфейс `io.Writer`, поэтому вместо `os.Stdout`
+// можно передать файл или, например, `strings.Builder`.
+// В `fields` хранятся пары `ключ=значение`, добавленные через `With`.
+type Logger struct {
+	out    io.Writer
+	min    LogLevel
+	fields []string
+}
+
+func NewLogger(out io.Writer, min LogLevel) *Logger {
+	return &Logger{out: out, min: min}
+}
+
+// log — общая часть всех методов. Аргументы объединяются
+// через `fmt.Sprintln`, как в `fmt.Println`, поэтому между
+// ними ставятся пробелы, а в конце — перевод строки.
+func (l *Logger) log(level LogLevel, args ...any) {
+	if level < l.min {
+		return
+	}
+	if len(l.fields) > 0 {
+		args = append([]any{strings.Join(l.fields, " ")}, args...)
+	}
+	fmt.Fprintf(l.out, "[%s] %s", level, fmt.Sprintln(args...))
+}
+
+// With возвращает дочерний логгер, который добавляет
+// `key=val` в начало каждого сообщения. Исходный логгер
+// не меняется: поля копируются в новый срез, а не дописываются
+// через `append` в общий, иначе два дочерних логгера могли бы
+// затереть поля друг друга в общем базовом массиве.
+func (l *Logger) With(key string, val any) *Logger {
+	fields := make([]string, len(l.fields), len(l.fields)+1)
+	copy(fields, l.fields)
+	fields = append(fields, fmt.Sprintf("%s=%v", key, val))
+	return &Logger{out: l.out, min: l.min, fields: fields}
+}
+
+// Методы-обёртки передают свои вариативные аргументы
+// дальше с помощью `args...`.
+func (l *Logger) Debug(args ...any) { l.log(LevelDebug, args...) }
+func (l *Logger) Info(args ...any)  { l.log(LevelInfo, args...) }
+func (l *Logger) Warn(args ...any)  { l.log(LevelWarn, args...) }
+func (l *Logger) Error(args ...any) { l.log(LevelError, args...) }
+
+func main() {
+	log := NewLogger(os.Stdout, LevelInfo)
+
+	// Сообщение уровня `DEBUG` ниже порога и не будет напечатано.
+	log.Debug("connecting to", "db:5432")
+	log.Info("server started on port", 8080)
+	log.Warn("disk usage at", 91, "%")
+	log.Error("request failed:", "timeout")
+
+	// Вывод можно перехватить, подставив другой `io.Writer`.
+	var b strings.Builder
+	quiet := NewLogger(&b, LevelError)
+	quiet.Warn("suppressed")
+	quiet.Error("captured")
+	fmt.Printf("captured: %q\n", b.String())
+
+	// Дочерние логгеры накапливают контекст, а родитель
+	// продолжает писать сообщения без него.
+	reqLog := log.With("request", 42)
+	reqLog.With("user", "gopher").Info("login ok")
+	reqLog.Warn("slow response")
+	log.Info("no context here")
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoggerLevels(t *testing.T) {
+	var b strings.Builder
+	log := NewLogger(&b, LevelWarn)
+	log.Debug("debug")
+	log.Info("info")
+	log.Warn("disk usage at", 91, "%")
+	log.Error("request failed:", "timeout")
+
+	// Сообщения ниже порога отброшены; аргументы разделены пробелами.
+	want := "[WARN] disk usage at 91 %\n[ERROR] request failed: timeout\n"
+	if got := b.String(); got != want {
+		t.Errorf("output = %q; want %q", got, want)
+	}
+}
+
+func TestLoggerDebugLevel(t *testing.T) {
+	var b strings.Builder
+	NewLogger(&b, LevelDebug).Debug("connecting to", "db:5432")
+	if got, want := b.String(), "[DEBUG] connecting to db:5432\n"; got != want {
+		t.Errorf("output = %q; want %q", got, want)
+	}
+}
+
+func TestLoggerWith(t *testing.T) {
+	var b strings.Builder
+	log := NewLogger(&b, LevelInfo)
+	reqLog := log.With("request", 42)
+	reqLog.With("user", "gopher").Info("login ok")
+	reqLog.Warn("slow response")
+	log.Info("no context here")
+
+	want := "[INFO] request=42 user=gopher login ok\n" +
+		"[WARN] request=42 slow response\n" +
+		"[INFO] no context here\n"
+	if got := b.String(); got != want {
+		t.Errorf("output = %q; want %q", got, want)
+	}
+}
+
+func TestLoggerWithSiblings(t *testing.T) {
+	var b strings.Builder
+	// Ёмкость полей родителя больше длины: общий базовый
+	// массив выдал бы себя, если бы With делал append в него.
+	parent := NewLogger(&b, LevelInfo).With("a", 1).With("b", 2).With("c", 3)
+	x := parent.With("x", "x")
+	y := parent.With("y", "y")
+	x.Info("1")
+	y.Info("2")
+
+	want := "[INFO] a=1 b=2 c=3 x=x 1\n[INFO] a=1 b=2 c=3 y=y 2\n"
+	if got := b.String(); got != want {
+		t.Errorf("output = %q; want %q", got, want)
+	}
+}
+
+func TestLoggerWithKeepsLevel(t *testing.T) {
+	var b strings.Builder
+	NewLogger(&b, LevelError).With("k", "v").Warn("suppressed")
+	if b.Len() != 0 {
+		t.Errorf("output = %q; want empty", b.String())
+	}
+}
@@ -0,0 +1,135 @@
+// [_LRU-кэш_](https://en.wikipedia.org/wiki/Cache_replacement_policies#LRU)
+// (least recently used) хранит ограниченное число значений и при
+// переполнении вытесняет то, к которому дольше всего не обращались.
+// Чтобы и поиск, и обновление "свежести" занимали O(1), кэш
+// сочетает карту с двунаправленным списком.
+
+package main
+
+import "fmt"
+
+// Двунаправленный список `DList` похож на однонаправленный `List`
+// из примера об обобщениях, но каждый узел знает и предыдущий
+// элемент. Благодаря этому узел можно удалить или переместить
+// в начало за O(1), не проходя по списку.
+type DList[T any] struct {
+	head, tail *node[T]
+	len        int
+}
+
+type node[T any] struct {
+	prev, next *node[T]
+	val        T
+}
+
+// PushFront добавляет значение в начало списка и возвращает его узел.
+func (l *DList[T]) PushFront(v T) *node[T] {
+	n := &node[T]{val: v, next: l.head}
+	if l.head != nil {
+		l.head.prev = n
+	} else {
+		l.tail = n
+	}
+	l.head = n
+	l.len++
+	return n
+}
+
+// Remove вырезает узел из списка, связывая его соседей друг с другом.
+func (l *DList[T]) Remove(n *node[T]) {
+	if n.prev != nil {
+		n.prev.next = n.next
+	} else {
+		l.head = n.next
+	}
+	if n.next != nil {
+		n.next.prev = n.prev
+	} else {
+		l.tail = n.prev
+	}
+	n.prev, n.next = nil, nil
+	l.len--
+}
+
+// MoveToFront переносит существующий узел в начало списка.
+func (l *DList[T]) MoveToFront(n *node[T]) {
+	if l.head == n {
+		return
+	}
+	l.Remove(n)
+	n.next = l.head
+	l.head.prev = n
+	l.head = n
+	l.len++
+}
+
+// entry — значение узла списка: ключ нужен, чтобы при
+// вытеснении последнего узла удалить его и из карты.
+type entry[K comparable, V any] struct {
+	key K
+	val V
+}
+
+// `LRU` держит записи в списке от самой свежей (начало) к самой
+// старой (конец), а карта по ключу сразу даёт узел списка.
+type LRU[K comparable, V any] struct {
+	capacity int
+	items    map[K]*node[entry[K, V]]
+	order    DList[entry[K, V]]
+}
+
+// NewLRU создаёт кэш на `capacity` записей. Кэш, в который нельзя
+// положить ни одной записи, бессмыслен, поэтому `capacity < 1` —
+// ошибка программиста, и функция паникует, как `make` с
+// отрицательным размером.
+func NewLRU[K comparable, V any](capacity int) *LRU[K, V] {
+	if capacity < 1 {
+		panic(fmt.Sprintf("NewLRU: capacity must be at least 1, got %d", capacity))
+	}
+	return &LRU[K, V]{capacity: capacity, items: make(map[K]*node[entry[K, V]])}
+}
+
+// Get возвращает значение по ключу и отмечает его как самое свежее.
+func (c *LRU[K, V]) Get(key K) (V, bool) {
+	n, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	c.order.MoveToFront(n)
+	return n.val.val, true
+}
+
+// Put добавляет или обновляет значение. Если кэш переполнен,
+// вытесняется запись из конца списка — самая старая.
+func (c *LRU[K, V]) Put(key K, val V) {
+	if n, ok := c.items[key]; ok {
+		n.val.val = val
+		c.order.MoveToFront(n)
+		return
+	}
+	if c.order.len == c.capacity {
+		oldest := c.order.tail
+		c.order.Remove(oldest)
+		delete(c.items, oldest.val.key)
+	}
+	c.items[key] = c.order.PushFront(entry[K, V]{key, val})
+}
+
+func main() {
+	cache := NewLRU[string, int](2)
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+
+	// Обращение к `a` делает её свежее, чем `b`...
+	v, ok := cache.Get("a")
+	fmt.Println("a:", v, ok)
+
+	// ...поэтому при добавлении `c` вытесняется `b`.
+	cache.Put("c", 3)
+	_, ok = cache.Get("b")
+	fmt.Println("b present:", ok)
+
+	v, _ = cache.Get("c")
+	fmt.Println("c:", v, "len:", len(cache.items))
+}
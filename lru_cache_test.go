@@ -0,0 +1,81 @@
+package main
+
+import "testing"
+
+func TestLRUGetPut(t *testing.T) {
+	c := NewLRU[string, int](2)
+	if _, ok := c.Get("a"); ok {
+		t.Error(`Get("a") on empty cache ok = true`)
+	}
+	c.Put("a", 1)
+	c.Put("b", 2)
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Errorf(`Get("a") = %d, %v; want 1, true`, v, ok)
+	}
+}
+
+func TestLRUEvictsLeastRecentlyUsed(t *testing.T) {
+	c := NewLRU[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a") // a свежее b
+	c.Put("c", 3)
+
+	if _, ok := c.Get("b"); ok {
+		t.Error(`Get("b") ok = true; want b evicted`)
+	}
+	for k, want := range map[string]int{"a": 1, "c": 3} {
+		if v, ok := c.Get(k); !ok || v != want {
+			t.Errorf("Get(%q) = %d, %v; want %d, true", k, v, ok, want)
+		}
+	}
+	if len(c.items) != 2 || c.order.len != 2 {
+		t.Errorf("len(items) = %d, order.len = %d; want 2, 2", len(c.items), c.order.len)
+	}
+}
+
+func TestLRUUpdateRefreshes(t *testing.T) {
+	c := NewLRU[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	// Обновление существующего ключа тоже делает его свежим
+	// и не увеличивает размер.
+	c.Put("a", 10)
+	c.Put("c", 3)
+
+	if v, ok := c.Get("a"); !ok || v != 10 {
+		t.Errorf(`Get("a") = %d, %v; want 10, true`, v, ok)
+	}
+	if _, ok := c.Get("b"); ok {
+		t.Error(`Get("b") ok = true; want b evicted`)
+	}
+}
+
+func TestLRUCapacityOne(t *testing.T) {
+	c := NewLRU[int, int](1)
+	for i := 0; i < 5; i++ {
+		c.Put(i, i)
+	}
+	if v, ok := c.Get(4); !ok || v != 4 {
+		t.Errorf("Get(4) = %d, %v; want 4, true", v, ok)
+	}
+	if _, ok := c.Get(3); ok {
+		t.Error("Get(3) ok = true; want evicted")
+	}
+	if c.order.head != c.order.tail {
+		t.Error("head != tail for single-entry list")
+	}
+}
+
+func TestNewLRURejectsCapacity(t *testing.T) {
+	for _, capacity := range []int{0, -1} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewLRU(%d) did not panic", capacity)
+				}
+			}()
+			NewLRU[string, int](capacity)
+		}()
+	}
+}
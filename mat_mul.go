@@ -0,0 +1,89 @@
+// Умножение матриц — хороший пример работы со срезами срезов.
+// Матрица здесь — `[][]float64`, где каждый внутренний срез —
+// строка. Такая структура не гарантирует, что строки одной
+// длины, поэтому размеры нужно проверять.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrJaggedMatrix      = errors.New("matrix rows have different lengths")
+	ErrDimensionMismatch = errors.New("matrix dimensions do not match")
+)
+
+// dims возвращает число строк и столбцов `m` или
+// `ErrJaggedMatrix`, если строки разной длины.
+func dims(m [][]float64) (rows, cols int, err error) {
+	if len(m) == 0 {
+		return 0, 0, nil
+	}
+	cols = len(m[0])
+	for i, row := range m {
+		if len(row) != cols {
+			return 0, 0, fmt.Errorf("row %d has %d columns, want %d: %w",
+				i, len(row), cols, ErrJaggedMatrix)
+		}
+	}
+	return len(m), cols, nil
+}
+
+// MatMul возвращает произведение `a` (n×m) и `b` (m×p) — матрицу
+// n×p, где элемент [i][j] — сумма произведений i-й строки `a`
+// на j-й столбец `b`. Число столбцов `a` должно совпадать с
+// числом строк `b`, иначе возвращается `ErrDimensionMismatch`.
+func MatMul(a, b [][]float64) ([][]float64, error) {
+	n, m, err := dims(a)
+	if err != nil {
+		return nil, err
+	}
+	m2, p, err := dims(b)
+	if err != nil {
+		return nil, err
+	}
+	if m != m2 {
+		return nil, fmt.Errorf("%d×%d by %d×%d: %w", n, m, m2, p, ErrDimensionMismatch)
+	}
+
+	c := make([][]float64, n)
+	for i := range c {
+		c[i] = make([]float64, p)
+		for j := range p {
+			for k := range m {
+				c[i][j] += a[i][k] * b[k][j]
+			}
+		}
+	}
+	return c, nil
+}
+
+func main() {
+	a := [][]float64{
+		{1, 2, 3},
+		{4, 5, 6},
+	}
+	b := [][]float64{
+		{7, 8},
+		{9, 10},
+		{11, 12},
+	}
+	fmt.Println(MatMul(a, b))
+
+	// Умножение на единичную матрицу не меняет матрицу.
+	id := [][]float64{
+		{1, 0, 0},
+		{0, 1, 0},
+		{0, 0, 1},
+	}
+	fmt.Println(MatMul(a, id))
+
+	// 2×3 на 2×3 умножить нельзя.
+	_, err := MatMul(a, a)
+	fmt.Println(err)
+
+	_, err = MatMul(a, [][]float64{{1}, {2, 3}, {4}})
+	fmt.Println(err, errors.Is(err, ErrJaggedMatrix))
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestMatMul(t *testing.T) {
+	a := [][]float64{
+		{1, 2, 3},
+		{4, 5, 6},
+	}
+	var tests = []struct {
+		name string
+		a, b [][]float64
+		want [][]float64
+	}{
+		{"2x3 by 3x2", a, [][]float64{{7, 8}, {9, 10}, {11, 12}}, [][]float64{{58, 64}, {139, 154}}},
+		{"identity", a, [][]float64{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}, a},
+		{"row by column", [][]float64{{1, 2, 3}}, [][]float64{{4}, {5}, {6}}, [][]float64{{32}}},
+		{"column by row", [][]float64{{1}, {2}}, [][]float64{{3, 4}}, [][]float64{{3, 4}, {6, 8}}},
+		{"empty", nil, nil, [][]float64{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MatMul(tt.a, tt.b)
+			if err != nil || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MatMul() = %v, %v; want %v, nil", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatMulErrors(t *testing.T) {
+	a := [][]float64{{1, 2, 3}, {4, 5, 6}}
+	jagged := [][]float64{{1}, {2, 3}, {4}}
+
+	var tests = []struct {
+		name string
+		a, b [][]float64
+		want error
+	}{
+		{"2x3 by 2x3", a, a, ErrDimensionMismatch},
+		{"jagged right", a, jagged, ErrJaggedMatrix},
+		{"jagged left", jagged, a, ErrJaggedMatrix},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MatMul(tt.a, tt.b)
+			if !errors.Is(err, tt.want) || got != nil {
+				t.Errorf("MatMul() = %v, %v; want nil, %v", got, err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+// Простой способ измерить, сколько времени выполняется
+// фрагмент кода: передать его как функцию и засечь время
+// до и после вызова. Для серьёзных замеров в Go есть
+// бенчмарки пакета `testing`, но для быстрой оценки этого хватает.
+
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+// Текущее время берётся из переменной `now`, которую можно
+// подменить поддельными часами.
+var now = time.Now
+
+// Time вызывает `fn` и возвращает длительность вызова.
+func Time(fn func()) time.Duration {
+	start := now()
+	fn()
+	return now().Sub(start)
+}
+
+// TimeN вызывает `fn` `n` раз и возвращает среднюю длительность
+// одного вызова. Среднее по нескольким запускам сглаживает
+// случайные задержки. Для `n < 1` возвращается 0.
+func TimeN(n int, fn func()) time.Duration {
+	if n < 1 {
+		return 0
+	}
+	return Time(func() {
+		for i := 0; i < n; i++ {
+			fn()
+		}
+	}) / time.Duration(n)
+}
+
+func main() {
+	// Настоящие часы: результат зависит от машины, но
+	// не может быть меньше времени сна.
+	d := Time(func() { time.Sleep(20 * time.Millisecond) })
+	fmt.Println("slept at least 20ms:", d >= 20*time.Millisecond)
+
+	// Поддельные часы, которые каждый вызов `fn` сдвигает
+	// ровно на 10мс, дают детерминированный результат.
+	clock := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	now = func() time.Time { return clock }
+	work := func() { clock = clock.Add(10 * time.Millisecond) }
+
+	fmt.Println("time:", Time(work))
+	fmt.Println("average of 5:", TimeN(5, work))
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+// fakeClock подменяет `now` на время теста; каждый вызов `tick`
+// сдвигает поддельные часы на `step`.
+func fakeClock(t *testing.T, step time.Duration) (tick func()) {
+	clock := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	orig := now
+	now = func() time.Time { return clock }
+	t.Cleanup(func() { now = orig })
+	return func() { clock = clock.Add(step) }
+}
+
+func TestTime(t *testing.T) {
+	tick := fakeClock(t, 10*time.Millisecond)
+
+	calls := 0
+	got := Time(func() {
+		calls++
+		tick()
+		tick()
+	})
+	if calls != 1 {
+		t.Errorf("fn called %d times; want 1", calls)
+	}
+	if got != 20*time.Millisecond {
+		t.Errorf("Time = %v; want 20ms", got)
+	}
+}
+
+func TestTimeN(t *testing.T) {
+	tick := fakeClock(t, time.Millisecond)
+
+	// Вызовы длятся 1, 2, 3, 4 и 5мс: всего 15мс, в среднем 3мс.
+	calls := 0
+	got := TimeN(5, func() {
+		calls++
+		for i := 0; i < calls; i++ {
+			tick()
+		}
+	})
+	if calls != 5 {
+		t.Errorf("fn called %d times; want 5", calls)
+	}
+	if got != 3*time.Millisecond {
+		t.Errorf("TimeN = %v; want 3ms", got)
+	}
+}
+
+func TestTimeNNonPositive(t *testing.T) {
+	fakeClock(t, time.Millisecond)
+
+	calls := 0
+	for _, n := range []int{0, -1} {
+		if got := TimeN(n, func() { calls++ }); got != 0 {
+			t.Errorf("TimeN(%d) = %v; want 0", n, got)
+		}
+	}
+	if calls != 0 {
+		t.Errorf("fn called %d times; want 0", calls)
+	}
+}
@@ -0,0 +1,54 @@
+// Классическая задача на сортировку: объединить пересекающиеся
+// отрезки. После сортировки по началу перекрываться могут
+// только соседние отрезки, поэтому достаточно одного прохода.
+
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// MergeIntervals объединяет пересекающиеся и касающиеся отрезки
+// `[начало, конец]`: `[1,3]` и `[2,5]` дают `[1,5]`, как и
+// `[1,3]` и `[3,5]`. Результат отсортирован по началу.
+// Исходный срез не меняется; для пустого ввода результат `nil`.
+func MergeIntervals(intervals [][2]int) [][2]int {
+	if len(intervals) == 0 {
+		return nil
+	}
+
+	sorted := slices.Clone(intervals)
+	slices.SortFunc(sorted, func(a, b [2]int) int {
+		return cmp.Compare(a[0], b[0])
+	})
+
+	merged := [][2]int{sorted[0]}
+	for _, cur := range sorted[1:] {
+		last := &merged[len(merged)-1]
+		if cur[0] <= last[1] {
+			last[1] = max(last[1], cur[1])
+		} else {
+			merged = append(merged, cur)
+		}
+	}
+	return merged
+}
+
+func main() {
+	// Пересекающиеся отрезки, к тому же не по порядку.
+	fmt.Println(MergeIntervals([][2]int{{8, 10}, {1, 3}, {2, 6}, {15, 18}}))
+
+	// Касающиеся отрезки тоже сливаются.
+	fmt.Println(MergeIntervals([][2]int{{1, 4}, {4, 5}}))
+
+	// Отрезок внутри другого поглощается им.
+	fmt.Println(MergeIntervals([][2]int{{1, 10}, {2, 3}, {4, 5}}))
+
+	// Непересекающиеся отрезки остаются как есть.
+	fmt.Println(MergeIntervals([][2]int{{5, 6}, {1, 2}, {3, 4}}))
+
+	fmt.Println(MergeIntervals([][2]int{{7, 9}}))
+	fmt.Println(MergeIntervals(nil))
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMergeIntervals(t *testing.T) {
+	var tests = []struct {
+		name string
+		in   [][2]int
+		want [][2]int
+	}{
+		{"overlapping unsorted", [][2]int{{8, 10}, {1, 3}, {2, 6}, {15, 18}}, [][2]int{{1, 6}, {8, 10}, {15, 18}}},
+		{"touching", [][2]int{{1, 4}, {4, 5}}, [][2]int{{1, 5}}},
+		{"contained", [][2]int{{1, 10}, {2, 3}, {4, 5}}, [][2]int{{1, 10}}},
+		{"disjoint", [][2]int{{5, 6}, {1, 2}, {3, 4}}, [][2]int{{1, 2}, {3, 4}, {5, 6}}},
+		{"chain", [][2]int{{1, 2}, {2, 3}, {3, 4}}, [][2]int{{1, 4}}},
+		{"same start", [][2]int{{1, 5}, {1, 2}}, [][2]int{{1, 5}}},
+		{"single", [][2]int{{7, 9}}, [][2]int{{7, 9}}},
+		{"empty", nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MergeIntervals(tt.in); !slices.Equal(got, tt.want) {
+				t.Errorf("MergeIntervals(%v) = %v; want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeIntervalsDoesNotModify(t *testing.T) {
+	in := [][2]int{{8, 10}, {1, 3}, {2, 6}}
+	orig := slices.Clone(in)
+	MergeIntervals(in)
+	if !slices.Equal(in, orig) {
+		t.Errorf("input = %v after MergeIntervals; want %v", in, orig)
+	}
+}
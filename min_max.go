@@ -0,0 +1,43 @@
+// Встроенные `min` и `max` и функции `slices.Min`/`slices.Max`
+// находят по одному экстремуму за проход. Если нужны оба,
+// их можно найти за один проход по срезу.
+
+package main
+
+import (
+	"cmp"
+	"fmt"
+)
+
+// MinMax возвращает наименьший и наибольший элементы `s`.
+// Для пустого среза экстремумов нет: возвращаются нулевые
+// значения и `ok == false`, а не паника, как у `slices.Min`.
+// Ограничение `cmp.Ordered` допускает числа и строки — всё,
+// что сравнивается операторами `<` и `>`.
+func MinMax[T cmp.Ordered](s []T) (min, max T, ok bool) {
+	if len(s) == 0 {
+		return min, max, false
+	}
+	min, max = s[0], s[0]
+	for _, v := range s[1:] {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+	}
+	return min, max, true
+}
+
+func main() {
+	fmt.Println(MinMax([]int{3, -1, 4, 1, 5, 9, -2, 6}))
+	fmt.Println(MinMax([]string{"pear", "apple", "peach"}))
+
+	// Единственный элемент — одновременно и минимум, и максимум.
+	fmt.Println(MinMax([]float64{2.5}))
+
+	if _, _, ok := MinMax([]int{}); !ok {
+		fmt.Println("empty slice")
+	}
+}
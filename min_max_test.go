@@ -0,0 +1,32 @@
+package main
+
+import "testing"
+
+func TestMinMax(t *testing.T) {
+	var tests = []struct {
+		s                []int
+		wantMin, wantMax int
+		wantOK           bool
+	}{
+		{[]int{3, -1, 4, 1, 5, 9, -2, 6}, -2, 9, true},
+		{[]int{7}, 7, 7, true},
+		{[]int{2, 2, 2}, 2, 2, true},
+		{[]int{5, 4, 3, 2, 1}, 1, 5, true},
+		{[]int{}, 0, 0, false},
+		{nil, 0, 0, false},
+	}
+	for _, tt := range tests {
+		lo, hi, ok := MinMax(tt.s)
+		if lo != tt.wantMin || hi != tt.wantMax || ok != tt.wantOK {
+			t.Errorf("MinMax(%v) = %d, %d, %v; want %d, %d, %v",
+				tt.s, lo, hi, ok, tt.wantMin, tt.wantMax, tt.wantOK)
+		}
+	}
+}
+
+func TestMinMaxStrings(t *testing.T) {
+	lo, hi, ok := MinMax([]string{"pear", "apple", "peach"})
+	if lo != "apple" || hi != "pear" || !ok {
+		t.Errorf("MinMax() = %q, %q, %v; want apple, pear, true", lo, hi, ok)
+	}
+}
@@ -0,0 +1,51 @@
+// Найдём n-е [простое число](https://en.wikipedia.org/wiki/Prime_number).
+// Вместо рекурсии здесь удобнее итерация: мы перебираем кандидатов
+// и проверяем их делением только на уже найденные простые.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+// NthPrime возвращает n-е простое число, считая с единицы:
+// `NthPrime(1)` — это 2. Для `n < 1` возвращается ошибка.
+func NthPrime(n int) (int, error) {
+	if n < 1 {
+		return 0, errors.New("n must be at least 1")
+	}
+
+	primes := []int{2}
+	// Чётные числа больше 2 не бывают простыми, поэтому
+	// перебираем только нечётных кандидатов.
+	for c := 3; len(primes) < n; c += 2 {
+		isPrime := true
+		for _, p := range primes {
+			// Если у `c` есть делитель, то есть и делитель
+			// не больше `√c`, поэтому дальше можно не проверять.
+			if p*p > c {
+				break
+			}
+			if c%p == 0 {
+				isPrime = false
+				break
+			}
+		}
+		if isPrime {
+			primes = append(primes, c)
+		}
+	}
+	return primes[n-1], nil
+}
+
+func main() {
+	for _, n := range []int{1, 6, 1000, 0} {
+		p, err := NthPrime(n)
+		if err != nil {
+			fmt.Println(n, "error:", err)
+			continue
+		}
+		fmt.Println(n, "->", p)
+	}
+}
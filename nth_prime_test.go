@@ -0,0 +1,31 @@
+package main
+
+import "testing"
+
+func TestNthPrime(t *testing.T) {
+	var tests = []struct {
+		n, want int
+	}{
+		{1, 2},
+		{2, 3},
+		{3, 5},
+		{6, 13},
+		{25, 97},
+		{1000, 7919},
+		{10000, 104729},
+	}
+	for _, tt := range tests {
+		got, err := NthPrime(tt.n)
+		if err != nil || got != tt.want {
+			t.Errorf("NthPrime(%d) = %d, %v; want %d, nil", tt.n, got, err, tt.want)
+		}
+	}
+}
+
+func TestNthPrimeInvalid(t *testing.T) {
+	for _, n := range []int{0, -1} {
+		if _, err := NthPrime(n); err == nil {
+			t.Errorf("NthPrime(%d) error = nil; want error", n)
+		}
+	}
+}
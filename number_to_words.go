@@ -0,0 +1,86 @@
+// Запишем число словами по-английски: 1001 → "one thousand one".
+// Задача естественно решается рекурсией: число разбивается на
+// группы (миллиарды, миллионы, тысячи, остаток), и каждая группа
+// — это снова число поменьше, которое записывается той же функцией.
+
+package main
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+var ones = []string{
+	"zero", "one", "two", "three", "four", "five", "six", "seven",
+	"eight", "nine", "ten", "eleven", "twelve", "thirteen",
+	"fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen",
+}
+
+var tens = []string{
+	"", "", "twenty", "thirty", "forty", "fifty",
+	"sixty", "seventy", "eighty", "ninety",
+}
+
+// Разряды от старшего к младшему. Модуль любого `int` меньше
+// 10^19, поэтому квинтиллионов достаточно: без старших разрядов
+// число вроде 10^18 записалось бы как "one billion billion".
+var scales = []struct {
+	value uint64
+	name  string
+}{
+	{1_000_000_000_000_000_000, "quintillion"},
+	{1_000_000_000_000_000, "quadrillion"},
+	{1_000_000_000_000, "trillion"},
+	{1_000_000_000, "billion"},
+	{1_000_000, "million"},
+	{1_000, "thousand"},
+	{100, "hundred"},
+}
+
+// NumberToWords возвращает запись `n` словами. Отрицательные числа
+// получают префикс "minus".
+func NumberToWords(n int) string {
+	if n < 0 {
+		// Модуль считаем в `uint64`: `-n` для самого маленького
+		// `int` не помещается в `int`.
+		return "minus " + words(uint64(-(n+1))+1)
+	}
+	return words(uint64(n))
+}
+
+func words(n uint64) string {
+	// Базовые случаи рекурсии: числа до 100.
+	if n < 20 {
+		return ones[n]
+	}
+	if n < 100 {
+		if n%10 == 0 {
+			return tens[n/10]
+		}
+		return tens[n/10] + "-" + ones[n%10]
+	}
+
+	// Находим старший разряд и записываем количество его
+	// единиц и остаток рекурсивными вызовами.
+	for _, s := range scales {
+		if n < s.value {
+			continue
+		}
+		parts := []string{words(n / s.value), s.name}
+		if rest := n % s.value; rest != 0 {
+			parts = append(parts, words(rest))
+		}
+		return strings.Join(parts, " ")
+	}
+	panic("unreachable")
+}
+
+func main() {
+	for _, n := range []int{0, 7, 42, 100, 1001, 123456789, -15} {
+		fmt.Printf("%d: %s\n", n, NumberToWords(n))
+	}
+
+	// Самое маленькое `int` — больше девяти квинтиллионов по модулю.
+	fmt.Println(NumberToWords(math.MinInt64))
+}
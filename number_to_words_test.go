@@ -0,0 +1,49 @@
+package main
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestNumberToWords(t *testing.T) {
+	var tests = []struct {
+		n    int
+		want string
+	}{
+		{0, "zero"},
+		{7, "seven"},
+		{19, "nineteen"},
+		{20, "twenty"},
+		{42, "forty-two"},
+		{100, "one hundred"},
+		{101, "one hundred one"},
+		{1001, "one thousand one"},
+		{1_000_000, "one million"},
+		{123456789, "one hundred twenty-three million four hundred fifty-six thousand seven hundred eighty-nine"},
+		{-15, "minus fifteen"},
+		{1_000_000_000_000, "one trillion"},
+		{1_000_000_000_000_000_000, "one quintillion"},
+		{2_000_000_000_000_000_005, "two quintillion five"},
+		{math.MaxInt64, "nine quintillion two hundred twenty-three quadrillion three hundred seventy-two trillion thirty-six billion eight hundred fifty-four million seven hundred seventy-five thousand eight hundred seven"},
+		{math.MinInt64, "minus nine quintillion two hundred twenty-three quadrillion three hundred seventy-two trillion thirty-six billion eight hundred fifty-four million seven hundred seventy-five thousand eight hundred eight"},
+	}
+	for _, tt := range tests {
+		if got := NumberToWords(tt.n); got != tt.want {
+			t.Errorf("NumberToWords(%d) = %q; want %q", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestNumberToWordsNoRepeatedScale(t *testing.T) {
+	// Ни один разряд не должен повторяться подряд, как в
+	// "one billion billion".
+	for _, n := range []int{1e9, 1e12, 1e15, 1e18, math.MaxInt64} {
+		fields := strings.Fields(NumberToWords(n))
+		for i := 1; i < len(fields); i++ {
+			if fields[i] == fields[i-1] {
+				t.Errorf("NumberToWords(%d) = %q repeats %q", n, NumberToWords(n), fields[i])
+			}
+		}
+	}
+}
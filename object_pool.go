@@ -0,0 +1,85 @@
+// _Пул объектов_ позволяет переиспользовать дорогие в создании
+// значения (буферы, соединения) вместо того, чтобы создавать их
+// заново. Объединим обобщения и буферизованный канал: канал
+// сам по себе — готовая потокобезопасная очередь ограниченного
+// размера. В стандартной библиотеке есть похожий
+// [sync.Pool](https://pkg.go.dev/sync#Pool).
+
+package main
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// `Pool` хранит свободные объекты в буферизованном канале
+// `items` и создаёт новые через `factory`, когда канал пуст.
+type Pool[T any] struct {
+	items   chan T
+	factory func() T
+}
+
+// NewPool создаёт пул, хранящий не более `maxSize` свободных
+// объектов. Это ограничение только на простаивающие объекты:
+// `Get` никогда не блокируется и может создать сколько угодно
+// новых, а лишние объекты, возвращённые через `Put` в полный пул,
+// отбрасываются и достаются сборщику мусора. `maxSize == 0`
+// допустим — такой пул ничего не хранит, — а отрицательный размер
+// — ошибка программиста, и функция паникует, как `NewLRU`.
+func NewPool[T any](maxSize int, factory func() T) *Pool[T] {
+	if maxSize < 0 {
+		panic(fmt.Sprintf("NewPool: maxSize must not be negative, got %d", maxSize))
+	}
+	return &Pool[T]{items: make(chan T, maxSize), factory: factory}
+}
+
+// Get возвращает свободный объект или создаёт новый. Неблокирующее
+// получение через `select` с `default` — как в примере о
+// неблокирующих операциях с каналами.
+func (p *Pool[T]) Get() T {
+	select {
+	case v := <-p.items:
+		return v
+	default:
+		return p.factory()
+	}
+}
+
+// Put возвращает объект в пул или отбрасывает его, если пул полон.
+func (p *Pool[T]) Put(v T) {
+	select {
+	case p.items <- v:
+	default:
+	}
+}
+
+func main() {
+	// Счётчик созданий — атомарный, потому что `factory`
+	// вызывается из разных горутин.
+	var created atomic.Int64
+	pool := NewPool(4, func() []byte {
+		created.Add(1)
+		return make([]byte, 1024)
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				buf := pool.Get()
+				buf[0] = byte(j)
+				pool.Put(buf)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Из 800 вызовов `Get` лишь немногие создали новый буфер;
+	// точное число зависит от планировщика, а в пуле в любой
+	// момент простаивает не больше `maxSize` буферов.
+	fmt.Println("reused buffers:", created.Load() < 800)
+	fmt.Println("idle within limit:", len(pool.items) <= 4)
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// countingPool создаёт пул, фабрика которого считает свои вызовы.
+// Счётчик атомарный: `Get` вызывает фабрику из разных горутин.
+func countingPool(maxSize int) (*Pool[[]byte], *atomic.Int64) {
+	var created atomic.Int64
+	pool := NewPool(maxSize, func() []byte {
+		created.Add(1)
+		return make([]byte, 16)
+	})
+	return pool, &created
+}
+
+func TestPoolReusesSequentially(t *testing.T) {
+	pool, created := countingPool(1)
+	for i := 0; i < 100; i++ {
+		pool.Put(pool.Get())
+	}
+	if got := created.Load(); got != 1 {
+		t.Errorf("factory called %d times, want 1", got)
+	}
+}
+
+// Если пул вмещает столько объектов, сколько горутин, лишнее
+// никогда не отбрасывается: новый объект создаётся только при
+// пустом пуле, то есть когда все живые объекты на руках у
+// горутин. Поэтому фабрика вызывается не больше раз, чем горутин,
+// при любом порядке выполнения.
+func TestPoolConcurrentBounded(t *testing.T) {
+	const workers = 8
+	pool, created := countingPool(workers)
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				buf := pool.Get()
+				buf[0] = byte(j)
+				pool.Put(buf)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := created.Load(); got < 1 || got > workers {
+		t.Errorf("factory called %d times, want 1..%d", got, workers)
+	}
+	if idle := len(pool.items); idle > workers {
+		t.Errorf("%d idle objects, want at most %d", idle, workers)
+	}
+}
+
+// Все горутины сначала одновременно держат по объекту, потом
+// все возвращают их в пул меньшего размера, потом снова берут.
+// Лишние объекты отбрасываются, и во втором раунде фабрика
+// вызывается ровно столько раз, сколько их не хватило.
+func TestPoolDiscardsOverflow(t *testing.T) {
+	const workers, maxSize = 8, 3
+	pool, created := countingPool(maxSize)
+
+	var held sync.WaitGroup
+	var returned sync.WaitGroup
+	var done sync.WaitGroup
+	held.Add(workers)
+	returned.Add(workers)
+	for i := 0; i < workers; i++ {
+		done.Add(1)
+		go func() {
+			defer done.Done()
+			buf := pool.Get()
+			held.Done()
+			held.Wait()
+
+			pool.Put(buf)
+			returned.Done()
+			returned.Wait()
+
+			pool.Get()
+		}()
+	}
+	done.Wait()
+
+	if got, want := created.Load(), int64(2*workers-maxSize); got != want {
+		t.Errorf("factory called %d times, want %d", got, want)
+	}
+}
+
+func TestNewPoolRejectsNegativeSize(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("NewPool did not panic on a negative maxSize")
+		}
+	}()
+	NewPool(-1, func() int { return 0 })
+}
@@ -0,0 +1,97 @@
+// Часто нужно сравнить каждый элемент последовательности с
+// предыдущим: найти приращения, проверить упорядоченность.
+// `Pairwise` выдаёт соседние пары с перекрытием — (a, b),
+// (b, c), ... — используя `iter.Seq2`, итератор по парам значений.
+
+package main
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+)
+
+// Тип `List` из [примера про итераторы](25_range_over_iterators.go),
+// сокращённый до того, что нужно здесь.
+type List[T any] struct {
+	head, tail *element[T]
+}
+
+type element[T any] struct {
+	next *element[T]
+	val  T
+}
+
+func (lst *List[T]) Push(v T) {
+	if lst.tail == nil {
+		lst.head = &element[T]{val: v}
+		lst.tail = lst.head
+	} else {
+		lst.tail.next = &element[T]{val: v}
+		lst.tail = lst.tail.next
+	}
+}
+
+func (lst *List[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := lst.head; e != nil; e = e.next {
+			if !yield(e.val) {
+				return
+			}
+		}
+	}
+}
+
+// Pairwise возвращает итератор по парам соседних элементов
+// `seq` (предыдущий, текущий). Из n элементов получается
+// n-1 пара; для последовательности короче двух элементов
+// итератор не выдаёт ничего.
+func Pairwise[T any](seq iter.Seq[T]) iter.Seq2[T, T] {
+	return func(yield func(T, T) bool) {
+		var prev T
+		first := true
+		for v := range seq {
+			if first {
+				prev, first = v, false
+				continue
+			}
+			if !yield(prev, v) {
+				return
+			}
+			prev = v
+		}
+	}
+}
+
+func main() {
+	lst := List[int]{}
+	for _, v := range []int{1, 4, 9, 16} {
+		lst.Push(v)
+	}
+
+	// Разности соседних квадратов.
+	for prev, curr := range Pairwise(lst.All()) {
+		fmt.Printf("%d -> %d: +%d\n", prev, curr, curr-prev)
+	}
+
+	// Из одного элемента или пустой последовательности
+	// пар не получается.
+	count := func(s []int) int {
+		n := 0
+		for range Pairwise(slices.Values(s)) {
+			n++
+		}
+		return n
+	}
+	fmt.Println("single:", count([]int{42}), "empty:", count(nil))
+
+	// Проверка, что последовательность не убывает.
+	sorted := true
+	for a, b := range Pairwise(slices.Values([]string{"a", "c", "b"})) {
+		if a > b {
+			sorted = false
+			break
+		}
+	}
+	fmt.Println("sorted:", sorted)
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func pairs(s []int) [][2]int {
+	var out [][2]int
+	for a, b := range Pairwise(slices.Values(s)) {
+		out = append(out, [2]int{a, b})
+	}
+	return out
+}
+
+func TestPairwise(t *testing.T) {
+	var tests = []struct {
+		in   []int
+		want [][2]int
+	}{
+		{[]int{1, 4, 9, 16}, [][2]int{{1, 4}, {4, 9}, {9, 16}}},
+		{[]int{1, 2}, [][2]int{{1, 2}}},
+		{[]int{42}, nil},
+		{nil, nil},
+	}
+	for _, tt := range tests {
+		if got := pairs(tt.in); !slices.Equal(got, tt.want) {
+			t.Errorf("Pairwise(%v) = %v; want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestPairwiseList(t *testing.T) {
+	lst := List[string]{}
+	for _, s := range []string{"a", "b", "c"} {
+		lst.Push(s)
+	}
+	var got []string
+	for a, b := range Pairwise(lst.All()) {
+		got = append(got, a+b)
+	}
+	if want := []string{"ab", "bc"}; !slices.Equal(got, want) {
+		t.Errorf("Pairwise(list) = %v; want %v", got, want)
+	}
+}
+
+func TestPairwiseBreak(t *testing.T) {
+	n := 0
+	for range Pairwise(slices.Values([]int{1, 2, 3, 4})) {
+		n++
+		if n == 2 {
+			break
+		}
+	}
+	if n != 2 {
+		t.Errorf("got %d pairs; want 2", n)
+	}
+}
@@ -0,0 +1,30 @@
+// Небольшая, но постоянно нужная функция: выбрать форму
+// слова в зависимости от количества, чтобы не печатать
+// "1 apples" или "3 apple".
+
+package main
+
+import "fmt"
+
+// Pluralize возвращает количество вместе с подходящей формой
+// слова: единственное число только для `count == 1` (и `-1`),
+// во всех остальных случаях, включая 0, — множественное.
+// Это правило английского языка; в русском форм больше
+// ("1 яблоко", "2 яблока", "5 яблок"), и одной функции
+// с двумя формами для него недостаточно.
+func Pluralize(count int, singular, plural string) string {
+	word := plural
+	if count == 1 || count == -1 {
+		word = singular
+	}
+	return fmt.Sprintf("%d %s", count, word)
+}
+
+func main() {
+	for _, n := range []int{0, 1, 3} {
+		fmt.Println(Pluralize(n, "apple", "apples"))
+	}
+
+	// Неправильное множественное число передаётся явно.
+	fmt.Println(Pluralize(2, "mouse", "mice"))
+}
@@ -0,0 +1,27 @@
+package main
+
+import "testing"
+
+func TestPluralize(t *testing.T) {
+	var tests = []struct {
+		count int
+		want  string
+	}{
+		{0, "0 apples"},
+		{1, "1 apple"},
+		{-1, "-1 apple"},
+		{2, "2 apples"},
+		{-2, "-2 apples"},
+		{21, "21 apples"},
+	}
+	for _, tt := range tests {
+		if got := Pluralize(tt.count, "apple", "apples"); got != tt.want {
+			t.Errorf("Pluralize(%d) = %q; want %q", tt.count, got, tt.want)
+		}
+	}
+
+	// Неправильное множественное число передаётся явно.
+	if got := Pluralize(2, "mouse", "mice"); got != "2 mice" {
+		t.Errorf("Pluralize(2, mouse, mice) = %q; want %q", got, "2 mice")
+	}
+}
@@ -0,0 +1,66 @@
+// Неблокирующее получение из [примера](35_non_blocking_channel_operations.go)
+// можно повторять в цикле — это опрос (polling). Между попытками
+// горутина спит и может заниматься другими делами, а не висеть
+// на канале. Обычно достаточно простого `range` по каналу, но
+// опрос полезен, когда между проверками нужно что-то делать.
+
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+// Паузы между опросами выполняются через переменную `sleep`,
+// как в `Retry`, чтобы их можно было подменить.
+var sleep = time.Sleep
+
+// PollUntil проверяет `ch` неблокирующим получением и, если
+// значения нет, спит `interval` до следующей проверки. Значения,
+// не прошедшие `cond`, отбрасываются. Возвращается первое
+// значение, для которого `cond` истинно, и `true`; если канал
+// закрылся раньше — нулевое значение и `false`.
+func PollUntil[T any](ch <-chan T, interval time.Duration, cond func(T) bool) (T, bool) {
+	for {
+		select {
+		case v, ok := <-ch:
+			if !ok {
+				var zero T
+				return zero, false
+			}
+			if cond(v) {
+				return v, true
+			}
+		default:
+			sleep(interval)
+		}
+	}
+}
+
+func main() {
+	even := func(n int) bool { return n%2 == 0 }
+
+	// Значения приходят не сразу; подходящее — третье. Буфер
+	// нужен, чтобы отправитель не застрял на последнем значении,
+	// которое никто не прочитает.
+	ch := make(chan int, 4)
+	go func() {
+		for _, v := range []int{1, 3, 4, 5} {
+			time.Sleep(10 * time.Millisecond)
+			ch <- v
+		}
+	}()
+	v, ok := PollUntil(ch, time.Millisecond, even)
+	fmt.Println(v, ok)
+
+	// Канал закрывается раньше, чем приходит подходящее значение.
+	// Здесь ждать незачем, поэтому паузы только считаем.
+	polls := 0
+	sleep = func(time.Duration) { polls++ }
+	odd := make(chan int, 2)
+	odd <- 1
+	odd <- 3
+	close(odd)
+	v, ok = PollUntil(odd, time.Second, even)
+	fmt.Println(v, ok, "polls:", polls)
+}
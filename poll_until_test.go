@@ -0,0 +1,83 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+// fakeSleep подменяет `sleep`: вместо паузы записывает её
+// и вызывает `onSleep`, например чтобы отправить в канал
+// следующее значение.
+func fakeSleep(t *testing.T, onSleep func()) *[]time.Duration {
+	t.Helper()
+	var slept []time.Duration
+	orig := sleep
+	sleep = func(d time.Duration) {
+		slept = append(slept, d)
+		if onSleep != nil {
+			onSleep()
+		}
+	}
+	t.Cleanup(func() { sleep = orig })
+	return &slept
+}
+
+func isEven(n int) bool { return n%2 == 0 }
+
+func TestPollUntilFound(t *testing.T) {
+	// Каждая "пауза" доставляет в канал следующее значение.
+	ch := make(chan int, 4)
+	values := []int{1, 3, 4, 5}
+	slept := fakeSleep(t, func() {
+		ch <- values[0]
+		values = values[1:]
+	})
+
+	v, ok := PollUntil(ch, time.Millisecond, isEven)
+	if !ok || v != 4 {
+		t.Errorf("PollUntil() = %d, %v; want 4, true", v, ok)
+	}
+	// Перед каждым из трёх значений канал был пуст.
+	if len(*slept) != 3 {
+		t.Errorf("slept %d times; want 3", len(*slept))
+	}
+	for _, d := range *slept {
+		if d != time.Millisecond {
+			t.Errorf("slept %v; want %v", d, time.Millisecond)
+		}
+	}
+}
+
+func TestPollUntilReadyWithoutSleep(t *testing.T) {
+	slept := fakeSleep(t, nil)
+	ch := make(chan int, 1)
+	ch <- 2
+	if v, ok := PollUntil(ch, time.Second, isEven); !ok || v != 2 {
+		t.Errorf("PollUntil() = %d, %v; want 2, true", v, ok)
+	}
+	if len(*slept) != 0 {
+		t.Errorf("slept %d times; want 0", len(*slept))
+	}
+}
+
+func TestPollUntilClosed(t *testing.T) {
+	slept := fakeSleep(t, nil)
+	ch := make(chan int, 2)
+	ch <- 1
+	ch <- 3
+	close(ch)
+	if v, ok := PollUntil(ch, time.Second, isEven); ok || v != 0 {
+		t.Errorf("PollUntil() = %d, %v; want 0, false", v, ok)
+	}
+	if len(*slept) != 0 {
+		t.Errorf("slept %d times; want 0", len(*slept))
+	}
+}
+
+func TestPollUntilClosedWhileWaiting(t *testing.T) {
+	ch := make(chan int)
+	fakeSleep(t, func() { close(ch) })
+	if v, ok := PollUntil(ch, time.Second, isEven); ok || v != 0 {
+		t.Errorf("PollUntil() = %d, %v; want 0, false", v, ok)
+	}
+}
@@ -0,0 +1,89 @@
+// Объединим карты, срезы и рекурсию: напишем функцию,
+// которая печатает вложенные структуры из `map[string]any`
+// и `[]any` с отступами, в стиле JSON.
+
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// PrettyPrint возвращает текстовое представление `v`. Карты
+// печатаются в фигурных скобках, срезы — в квадратных, каждый
+// уровень вложенности сдвигается на два пробела. Строки
+// заключаются в кавычки, остальные значения печатаются через `%v`.
+func PrettyPrint(v any) string {
+	var b strings.Builder
+	prettyPrint(&b, v, 0)
+	return b.String()
+}
+
+// prettyPrint рекурсивно пишет `v` в `b`; `depth` — текущий
+// уровень вложенности, от которого зависит отступ.
+func prettyPrint(b *strings.Builder, v any, depth int) {
+	indent := strings.Repeat("  ", depth+1)
+	closing := strings.Repeat("  ", depth)
+
+	// _Переключатель типов_ выбирает ветку по динамическому
+	// типу значения, лежащего в интерфейсе `any`.
+	switch v := v.(type) {
+	case map[string]any:
+		if len(v) == 0 {
+			b.WriteString("{}")
+			return
+		}
+		// Порядок итерации по карте не определён, поэтому
+		// сортируем ключи, чтобы вывод был детерминированным.
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+
+		b.WriteString("{\n")
+		for i, k := range keys {
+			fmt.Fprintf(b, "%s%q: ", indent, k)
+			prettyPrint(b, v[k], depth+1)
+			if i < len(keys)-1 {
+				b.WriteString(",")
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString(closing + "}")
+	case []any:
+		if len(v) == 0 {
+			b.WriteString("[]")
+			return
+		}
+		b.WriteString("[\n")
+		for i, e := range v {
+			b.WriteString(indent)
+			prettyPrint(b, e, depth+1)
+			if i < len(v)-1 {
+				b.WriteString(",")
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString(closing + "]")
+	case string:
+		fmt.Fprintf(b, "%q", v)
+	default:
+		fmt.Fprintf(b, "%v", v)
+	}
+}
+
+func main() {
+	v := map[string]any{
+		"name": "gopher",
+		"age":  14,
+		"tags": []any{"go", "mascot"},
+		"address": map[string]any{
+			"city": "Mountain View",
+			"zip":  94043,
+		},
+		"friends": []any{},
+	}
+	fmt.Println(PrettyPrint(v))
+}
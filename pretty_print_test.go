@@ -0,0 +1,66 @@
+package main
+
+import "testing"
+
+func TestPrettyPrintScalars(t *testing.T) {
+	var tests = []struct {
+		v    any
+		want string
+	}{
+		{"go", `"go"`},
+		{`a"b`, `"a\"b"`},
+		{42, "42"},
+		{true, "true"},
+		{nil, "<nil>"},
+		{map[string]any{}, "{}"},
+		{[]any{}, "[]"},
+	}
+	for _, tt := range tests {
+		if got := PrettyPrint(tt.v); got != tt.want {
+			t.Errorf("PrettyPrint(%#v) = %s; want %s", tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestPrettyPrintNested(t *testing.T) {
+	v := map[string]any{
+		"name": "gopher",
+		"tags": []any{"go", 1},
+		"address": map[string]any{
+			"zip":  94043,
+			"city": "Mountain View",
+		},
+		"friends": []any{},
+	}
+	// Ключи отсортированы, каждый уровень сдвинут на два пробела.
+	want := `{
+  "address": {
+    "city": "Mountain View",
+    "zip": 94043
+  },
+  "friends": [],
+  "name": "gopher",
+  "tags": [
+    "go",
+    1
+  ]
+}`
+	if got := PrettyPrint(v); got != want {
+		t.Errorf("PrettyPrint() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestPrettyPrintSliceOfMaps(t *testing.T) {
+	v := []any{map[string]any{"a": 1}, []any{[]any{}}}
+	want := `[
+  {
+    "a": 1
+  },
+  [
+    []
+  ]
+]`
+	if got := PrettyPrint(v); got != want {
+		t.Errorf("PrettyPrint() =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,63 @@
+// Простейший шаблонизатор: заменяет заполнители `{{key}}`
+// значениями из карты. Для реальных задач в стандартной
+// библиотеке есть [text/template](https://pkg.go.dev/text/template),
+// но ручная реализация хорошо показывает работу со строками,
+// рунами и ошибками.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Render возвращает `tmpl`, в котором каждый `{{key}}` заменён
+// на `vars[key]`. Пробелы вокруг имени ключа отбрасываются.
+// Незакрытый заполнитель и ключ, которого нет в карте, — ошибки.
+func Render(tmpl string, vars map[string]string) (string, error) {
+	var out strings.Builder
+	runes := []rune(tmpl)
+
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != '{' || i+1 == len(runes) || runes[i+1] != '{' {
+			out.WriteRune(runes[i])
+			continue
+		}
+
+		// Нашли `{{` — ищем соответствующую `}}`.
+		start := i
+		end := -1
+		for j := i + 2; j+1 < len(runes); j++ {
+			if runes[j] == '}' && runes[j+1] == '}' {
+				end = j
+				break
+			}
+		}
+		if end == -1 {
+			return "", fmt.Errorf("unclosed placeholder at position %d", start)
+		}
+
+		key := strings.TrimSpace(string(runes[start+2 : end]))
+		val, ok := vars[key]
+		if !ok {
+			return "", fmt.Errorf("missing value for %q", key)
+		}
+		out.WriteString(val)
+		i = end + 1
+	}
+	return out.String(), nil
+}
+
+func main() {
+	vars := map[string]string{"name": "Гофер", "lang": "Go"}
+
+	s, err := Render("Привет, {{name}}! Добро пожаловать в {{ lang }}.", vars)
+	fmt.Println(s, err)
+
+	_, err = Render("Привет, {{user}}!", vars)
+	fmt.Println("error:", err)
+
+	// Позиция ошибки считается в рунах, а не в байтах.
+	_, err = Render("Привет, {{name", vars)
+	fmt.Println("error:", err)
+}
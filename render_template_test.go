@@ -0,0 +1,49 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+	vars := map[string]string{"name": "Гофер", "lang": "Go", "empty": ""}
+	var tests = []struct {
+		tmpl string
+		want string
+	}{
+		{"Привет, {{name}}!", "Привет, Гофер!"},
+		{"{{ lang }} и {{lang}}", "Go и Go"},
+		{"{{name}}{{lang}}", "ГоферGo"},
+		{"[{{empty}}]", "[]"},
+		{"без заполнителей", "без заполнителей"},
+		{"", ""},
+		// Одиночные скобки остаются как есть.
+		{"{a} } {", "{a} } {"},
+		{"в конце {", "в конце {"},
+	}
+	for _, tt := range tests {
+		got, err := Render(tt.tmpl, vars)
+		if err != nil || got != tt.want {
+			t.Errorf("Render(%q) = %q, %v; want %q, nil", tt.tmpl, got, err, tt.want)
+		}
+	}
+}
+
+func TestRenderErrors(t *testing.T) {
+	vars := map[string]string{"name": "Гофер"}
+	var tests = []struct {
+		tmpl    string
+		wantErr string
+	}{
+		{"Привет, {{user}}!", `missing value for "user"`},
+		// Позиция считается в рунах: "Привет, " — восемь рун.
+		{"Привет, {{name", "unclosed placeholder at position 8"},
+		{"{{name}", "unclosed placeholder at position 0"},
+	}
+	for _, tt := range tests {
+		_, err := Render(tt.tmpl, vars)
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("Render(%q) error = %v; want %q", tt.tmpl, err, tt.wantErr)
+		}
+	}
+}
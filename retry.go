@@ -0,0 +1,83 @@
+// Временные сбои — обрыв соединения, перегруженный сервер —
+// часто проходят сами. Объединим ошибки, замыкания и пакет
+// `time`: будем повторять операцию с растущей паузой между
+// попытками, пока она не удастся или не кончатся попытки.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Паузы выполняются через переменную `sleep`, а не напрямую
+// через `time.Sleep`. Подменив её, например, в тестах, можно
+// проверить расписание попыток, не дожидаясь его в реальном времени.
+var sleep = time.Sleep
+
+var ErrNoAttempts = errors.New("retry: attempts must be at least 1")
+
+// Пауза не растёт дальше `maxBackoff`: при большом числе попыток
+// удвоение иначе переполнило бы `time.Duration` и дало бы
+// отрицательную паузу.
+const maxBackoff = time.Minute
+
+// Retry вызывает `fn` до `attempts` раз и возвращает `nil` при
+// первом успехе. Пауза растёт экспоненциально: `backoff` перед
+// второй попыткой, `2*backoff` перед третьей, `4*backoff` перед
+// четвёртой и так далее, но не больше `maxBackoff`. Если все
+// попытки неудачны, возвращается ошибка последней из них. При
+// `attempts < 1` `fn` не вызывается ни разу, и возвращается
+// `ErrNoAttempts` — иначе невыполненная работа выглядела бы
+// успешной.
+func Retry(attempts int, backoff time.Duration, fn func() error) error {
+	if attempts < 1 {
+		return ErrNoAttempts
+	}
+	backoff = min(backoff, maxBackoff)
+	var err error
+	for i := 0; i < attempts; i++ {
+		if i > 0 {
+			sleep(backoff)
+			// Сравниваем до умножения, чтобы само удвоение
+			// не переполнилось.
+			if backoff > maxBackoff/2 {
+				backoff = maxBackoff
+			} else {
+				backoff *= 2
+			}
+		}
+		if err = fn(); err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+func main() {
+	// Запоминаем паузы вместо того, чтобы действительно спать.
+	var pauses []time.Duration
+	sleep = func(d time.Duration) { pauses = append(pauses, d) }
+
+	// Замыкание считает вызовы и "чинится" с третьей попытки.
+	calls := 0
+	flaky := func() error {
+		calls++
+		if calls < 3 {
+			return fmt.Errorf("attempt %d failed", calls)
+		}
+		return nil
+	}
+	err := Retry(5, 100*time.Millisecond, flaky)
+	fmt.Println("flaky:", err, "calls:", calls, "pauses:", pauses)
+
+	pauses = nil
+	err = Retry(4, 100*time.Millisecond, func() error {
+		return errors.New("permanent failure")
+	})
+	fmt.Println("broken:", err, "pauses:", pauses)
+
+	err = Retry(0, 100*time.Millisecond, flaky)
+	fmt.Println("no attempts:", err, errors.Is(err, ErrNoAttempts))
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+// recordSleeps подменяет `sleep` на время теста: вместо ожидания
+// паузы записываются в срез.
+func recordSleeps(t *testing.T) *[]time.Duration {
+	var pauses []time.Duration
+	orig := sleep
+	sleep = func(d time.Duration) { pauses = append(pauses, d) }
+	t.Cleanup(func() { sleep = orig })
+	return &pauses
+}
+
+// failTimes возвращает функцию, которая проваливается `n` раз,
+// а затем срабатывает, и указатель на счётчик вызовов.
+func failTimes(n int) (func() error, *int) {
+	calls := 0
+	return func() error {
+		calls++
+		if calls <= n {
+			return errors.New("transient")
+		}
+		return nil
+	}, &calls
+}
+
+func TestRetrySuccessFirstTry(t *testing.T) {
+	pauses := recordSleeps(t)
+	fn, calls := failTimes(0)
+
+	if err := Retry(3, time.Second, fn); err != nil {
+		t.Fatalf("Retry: %v", err)
+	}
+	if *calls != 1 || len(*pauses) != 0 {
+		t.Errorf("calls = %d, pauses = %v; want 1 call and no pauses", *calls, *pauses)
+	}
+}
+
+func TestRetrySuccessAfterRetries(t *testing.T) {
+	pauses := recordSleeps(t)
+	fn, calls := failTimes(3)
+
+	if err := Retry(5, 100*time.Millisecond, fn); err != nil {
+		t.Fatalf("Retry: %v", err)
+	}
+	if *calls != 4 {
+		t.Errorf("calls = %d; want 4", *calls)
+	}
+	want := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond}
+	if !slices.Equal(*pauses, want) {
+		t.Errorf("pauses = %v; want %v", *pauses, want)
+	}
+}
+
+func TestRetryPermanentFailure(t *testing.T) {
+	pauses := recordSleeps(t)
+	errLast := errors.New("attempt 3")
+	calls := 0
+	err := Retry(3, time.Millisecond, func() error {
+		calls++
+		if calls == 3 {
+			return errLast
+		}
+		return errors.New("earlier attempt")
+	})
+
+	if err != errLast {
+		t.Errorf("error = %v; want the last error %v", err, errLast)
+	}
+	if calls != 3 || len(*pauses) != 2 {
+		t.Errorf("calls = %d, pauses = %v; want 3 calls and 2 pauses", calls, *pauses)
+	}
+}
+
+func TestRetryNoAttempts(t *testing.T) {
+	recordSleeps(t)
+	fn, calls := failTimes(0)
+
+	for _, attempts := range []int{0, -1} {
+		if err := Retry(attempts, time.Second, fn); !errors.Is(err, ErrNoAttempts) {
+			t.Errorf("Retry(%d) error = %v; want %v", attempts, err, ErrNoAttempts)
+		}
+	}
+	if *calls != 0 {
+		t.Errorf("fn called %d times; want 0", *calls)
+	}
+}
+
+func TestRetryBackoffCapped(t *testing.T) {
+	pauses := recordSleeps(t)
+	fn, _ := failTimes(100)
+
+	Retry(100, time.Second, fn)
+	for i, p := range *pauses {
+		if p <= 0 || p > maxBackoff {
+			t.Fatalf("pause %d = %v; want within (0, %v]", i, p, maxBackoff)
+		}
+	}
+	if last := (*pauses)[len(*pauses)-1]; last != maxBackoff {
+		t.Errorf("last pause = %v; want %v", last, maxBackoff)
+	}
+}
@@ -0,0 +1,76 @@
+// Преобразуем числа в [римскую запись](https://en.wikipedia.org/wiki/Roman_numerals)
+// и обратно. Стандартная запись покрывает числа от 1 до 3999:
+// нуля у римлян не было, а для 4000 понадобились бы символы
+// с чертой сверху.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Значения отсортированы по убыванию, а "вычитательные" пары
+// вроде `CM` (900) и `IV` (4) записаны как отдельные символы —
+// так жадный алгоритм сам выбирает правильную запись.
+var romanNumerals = []struct {
+	value  int
+	symbol string
+}{
+	{1000, "M"}, {900, "CM"}, {500, "D"}, {400, "CD"},
+	{100, "C"}, {90, "XC"}, {50, "L"}, {40, "XL"},
+	{10, "X"}, {9, "IX"}, {5, "V"}, {4, "IV"}, {1, "I"},
+}
+
+var ErrRomanRange = errors.New("roman numerals are defined for 1..3999")
+
+// ToRoman возвращает римскую запись числа от 1 до 3999.
+func ToRoman(n int) (string, error) {
+	if n < 1 || n > 3999 {
+		return "", ErrRomanRange
+	}
+	var b strings.Builder
+	for _, r := range romanNumerals {
+		for n >= r.value {
+			b.WriteString(r.symbol)
+			n -= r.value
+		}
+	}
+	return b.String(), nil
+}
+
+// FromRoman разбирает римскую запись. Принимается только
+// каноническая форма: `IIII` или `IC` — ошибка. Проще всего
+// проверить это, преобразовав результат обратно и сравнив.
+func FromRoman(s string) (int, error) {
+	n, rest := 0, s
+	for _, r := range romanNumerals {
+		for strings.HasPrefix(rest, r.symbol) {
+			n += r.value
+			rest = rest[len(r.symbol):]
+		}
+	}
+	if rest != "" || n == 0 {
+		return 0, fmt.Errorf("invalid roman numeral %q", s)
+	}
+	if canonical, err := ToRoman(n); err != nil || canonical != s {
+		return 0, fmt.Errorf("invalid roman numeral %q", s)
+	}
+	return n, nil
+}
+
+func main() {
+	for _, n := range []int{4, 9, 14, 40, 90, 400, 1994, 3999} {
+		s, _ := ToRoman(n)
+		back, _ := FromRoman(s)
+		fmt.Printf("%4d -> %-10s -> %d\n", n, s, back)
+	}
+
+	_, err := ToRoman(4000)
+	fmt.Println("error:", err)
+	_, err = FromRoman("IIII")
+	fmt.Println("error:", err)
+	_, err = FromRoman("MXQ")
+	fmt.Println("error:", err)
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestToRoman(t *testing.T) {
+	var tests = []struct {
+		n    int
+		want string
+	}{
+		{1, "I"},
+		{4, "IV"},
+		{9, "IX"},
+		{14, "XIV"},
+		{40, "XL"},
+		{90, "XC"},
+		{400, "CD"},
+		{1994, "MCMXCIV"},
+		{3999, "MMMCMXCIX"},
+	}
+	for _, tt := range tests {
+		got, err := ToRoman(tt.n)
+		if err != nil || got != tt.want {
+			t.Errorf("ToRoman(%d) = %q, %v; want %q, nil", tt.n, got, err, tt.want)
+		}
+	}
+}
+
+func TestToRomanRange(t *testing.T) {
+	for _, n := range []int{0, -1, 4000} {
+		if _, err := ToRoman(n); !errors.Is(err, ErrRomanRange) {
+			t.Errorf("ToRoman(%d) error = %v; want %v", n, err, ErrRomanRange)
+		}
+	}
+}
+
+func TestRomanRoundTrip(t *testing.T) {
+	for n := 1; n <= 3999; n++ {
+		s, err := ToRoman(n)
+		if err != nil {
+			t.Fatalf("ToRoman(%d) error = %v", n, err)
+		}
+		if got, err := FromRoman(s); err != nil || got != n {
+			t.Fatalf("FromRoman(%q) = %d, %v; want %d, nil", s, got, err, n)
+		}
+	}
+}
+
+func TestFromRomanInvalid(t *testing.T) {
+	for _, s := range []string{"", "IIII", "IC", "VV", "MXQ", "iv", "MMMM"} {
+		if n, err := FromRoman(s); err == nil {
+			t.Errorf("FromRoman(%q) = %d, nil; want error", s, n)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// _Циклический сдвиг_ переносит элементы с одного конца среза
+// на другой. Реализуем его на месте, без дополнительной памяти,
+// и проверку того, является ли один срез сдвигом другого.
+
+package main
+
+import (
+	"fmt"
+	"slices"
+)
+
+// RotateSlice сдвигает `s` на месте на `k` позиций влево:
+// элемент с индексом `k` становится первым. `k` берётся по
+// модулю длины, а отрицательное `k` сдвигает вправо.
+//
+// Сдвиг делается тремя разворотами: развернуть первые `k`
+// элементов, затем остальные, затем весь срез.
+func RotateSlice[T any](s []T, k int) {
+	n := len(s)
+	if n == 0 {
+		return
+	}
+	// В Go остаток от деления отрицательного числа отрицателен,
+	// поэтому приводим `k` к диапазону [0, n).
+	k = ((k % n) + n) % n
+	slices.Reverse(s[:k])
+	slices.Reverse(s[k:])
+	slices.Reverse(s)
+}
+
+// IsRotation сообщает, можно ли получить `b` циклическим сдвигом `a`.
+// Срезы разной длины сдвигами друг друга не являются.
+func IsRotation[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	n := len(a)
+	// Пробуем каждое смещение: `b` — сдвиг `a` на `k`,
+	// если `b[i] == a[(i+k)%n]` для всех `i`.
+	for k := 0; k < n; k++ {
+		match := true
+		for i := 0; i < n; i++ {
+			if b[i] != a[(i+k)%n] {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	// Цикл выше не выполняется для пустых срезов — они равны.
+	return n == 0
+}
+
+func main() {
+	s := []int{1, 2, 3, 4, 5}
+	RotateSlice(s, 2)
+	fmt.Println("left 2:", s)
+
+	RotateSlice(s, -2)
+	fmt.Println("back:", s)
+
+	// `k` больше длины берётся по модулю: 7 % 5 == 2.
+	RotateSlice(s, 7)
+	fmt.Println("left 7:", s)
+
+	fmt.Println(IsRotation([]int{1, 2, 3, 4}, []int{3, 4, 1, 2}))
+	fmt.Println(IsRotation([]int{1, 2, 3, 4}, []int{4, 3, 2, 1}))
+	fmt.Println(IsRotation([]int{1, 2, 3}, []int{1, 2}))
+}
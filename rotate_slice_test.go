@@ -0,0 +1,56 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRotateSlice(t *testing.T) {
+	var tests = []struct {
+		k    int
+		want []int
+	}{
+		{0, []int{1, 2, 3, 4, 5}},
+		{2, []int{3, 4, 5, 1, 2}},
+		{5, []int{1, 2, 3, 4, 5}},
+		{7, []int{3, 4, 5, 1, 2}},
+		{-2, []int{4, 5, 1, 2, 3}},
+		{-7, []int{4, 5, 1, 2, 3}},
+	}
+	for _, tt := range tests {
+		s := []int{1, 2, 3, 4, 5}
+		RotateSlice(s, tt.k)
+		if !slices.Equal(s, tt.want) {
+			t.Errorf("RotateSlice(s, %d) = %v; want %v", tt.k, s, tt.want)
+		}
+	}
+}
+
+func TestRotateSliceEmpty(t *testing.T) {
+	// Не должно быть деления на ноль.
+	var s []int
+	RotateSlice(s, 3)
+	if len(s) != 0 {
+		t.Errorf("RotateSlice(nil, 3) = %v; want []", s)
+	}
+}
+
+func TestIsRotation(t *testing.T) {
+	var tests = []struct {
+		a, b []int
+		want bool
+	}{
+		{[]int{1, 2, 3, 4}, []int{3, 4, 1, 2}, true},
+		{[]int{1, 2, 3, 4}, []int{1, 2, 3, 4}, true},
+		{[]int{1, 2, 3, 4}, []int{4, 3, 2, 1}, false},
+		{[]int{1, 2, 3}, []int{1, 2}, false},
+		{[]int{1, 1, 2}, []int{1, 2, 1}, true},
+		{[]int{1, 1, 2}, []int{1, 2, 2}, false},
+		{nil, []int{}, true},
+	}
+	for _, tt := range tests {
+		if got := IsRotation(tt.a, tt.b); got != tt.want {
+			t.Errorf("IsRotation(%v, %v) = %v; want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
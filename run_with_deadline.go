@@ -0,0 +1,104 @@
+// Объединим горутины, каналы, ошибки и пакет
+// [context](https://pkg.go.dev/context): запустим несколько задач
+// параллельно и дождёмся их, но не дольше крайнего срока,
+// заданного в контексте.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// result связывает ошибку задачи с её индексом, потому что
+// задачи завершаются в произвольном порядке.
+type result struct {
+	i   int
+	err error
+}
+
+// RunWithDeadline запускает каждую задачу в своей горутине и
+// возвращает срез ошибок в том же порядке, что и `tasks`.
+// Задачам передаётся `ctx`, поэтому, когда срок истекает, они
+// могут заметить `ctx.Done()` и прекратить работу. Для задач,
+// не успевших завершиться к этому моменту, в срезе будет
+// `ctx.Err()` — для крайнего срока это `context.DeadlineExceeded`.
+func RunWithDeadline(ctx context.Context, tasks []func(context.Context) error) []error {
+	errs := make([]error, len(tasks))
+	done := make([]bool, len(tasks))
+
+	// Канал буферизован на все задачи: опоздавшие горутины
+	// смогут отправить результат, даже когда его уже никто
+	// не читает, и не зависнут навсегда.
+	results := make(chan result, len(tasks))
+	for i, task := range tasks {
+		go func() {
+			results <- result{i, task(ctx)}
+		}()
+	}
+
+	record := func(r result) {
+		errs[r.i] = r.err
+		done[r.i] = true
+	}
+
+	for range tasks {
+		select {
+		case r := <-results:
+			record(r)
+		case <-ctx.Done():
+			// Если к этому моменту в канале уже лежат результаты,
+			// `select` мог выбрать `ctx.Done()` случайно. Забираем
+			// их без ожидания, чтобы завершённая задача не попала
+			// в просроченные.
+		drain:
+			for {
+				select {
+				case r := <-results:
+					record(r)
+				default:
+					break drain
+				}
+			}
+			for i := range errs {
+				if !done[i] {
+					errs[i] = ctx.Err()
+				}
+			}
+			return errs
+		}
+	}
+	return errs
+}
+
+// sleepTask возвращает задачу, которая "работает" `d`,
+// но прерывается, если контекст отменён раньше.
+func sleepTask(d time.Duration, err error) func(context.Context) error {
+	return func(ctx context.Context) error {
+		select {
+		case <-time.After(d):
+			return err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func main() {
+	tasks := []func(context.Context) error{
+		sleepTask(10*time.Millisecond, nil),
+		sleepTask(20*time.Millisecond, errors.New("bad input")),
+		sleepTask(time.Second, nil),
+	}
+
+	// Крайний срок в 100мс: первые две задачи успевают,
+	// третья — нет.
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	for i, err := range RunWithDeadline(ctx, tasks) {
+		fmt.Printf("task %d: %v (deadline: %v)\n", i, err, errors.Is(err, context.DeadlineExceeded))
+	}
+}
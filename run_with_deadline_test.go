@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// returning возвращает задачу, которая сразу завершается с `err`.
+func returning(err error) func(context.Context) error {
+	return func(context.Context) error { return err }
+}
+
+// blocking возвращает задачу, которая работает, пока не отменят
+// контекст.
+func blocking(ctx context.Context) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestRunWithDeadlineAllComplete(t *testing.T) {
+	errBad := errors.New("bad input")
+	tasks := []func(context.Context) error{
+		returning(nil),
+		returning(errBad),
+		returning(nil),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	errs := RunWithDeadline(ctx, tasks)
+
+	want := []error{nil, errBad, nil}
+	for i := range want {
+		if errs[i] != want[i] {
+			t.Errorf("task %d: error = %v; want %v", i, errs[i], want[i])
+		}
+	}
+}
+
+func TestRunWithDeadlineTrips(t *testing.T) {
+	errBad := errors.New("bad input")
+	tasks := []func(context.Context) error{
+		returning(nil),
+		blocking,
+		returning(errBad),
+		blocking,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	errs := RunWithDeadline(ctx, tasks)
+
+	if errs[0] != nil {
+		t.Errorf("task 0: error = %v; want nil", errs[0])
+	}
+	if errs[2] != errBad {
+		t.Errorf("task 2: error = %v; want %v", errs[2], errBad)
+	}
+	for _, i := range []int{1, 3} {
+		if !errors.Is(errs[i], context.DeadlineExceeded) {
+			t.Errorf("task %d: error = %v; want %v", i, errs[i], context.DeadlineExceeded)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+// Свёртка (fold) сводит последовательность к одному значению,
+// например к сумме. `Scan` делает то же, но выдаёт _каждое_
+// промежуточное значение аккумулятора — получаются нарастающие
+// итоги. Поскольку результат — снова итератор, `Scan` работает
+// и с бесконечными последовательностями.
+
+package main
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+)
+
+// Scan возвращает итератор, который для каждого элемента `seq`
+// вычисляет `acc = f(acc, v)`, начиная с `init`, и выдаёт
+// новое `acc`. Само `init` не выдаётся, поэтому значений ровно
+// столько же, сколько в `seq`.
+func Scan[T, A any](seq iter.Seq[T], init A, f func(A, T) A) iter.Seq[A] {
+	return func(yield func(A) bool) {
+		acc := init
+		for v := range seq {
+			acc = f(acc, v)
+			if !yield(acc) {
+				return
+			}
+		}
+	}
+}
+
+// Генератор чисел Фибоначчи из
+// [примера про итераторы](25_range_over_iterators.go).
+func genFib() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		a, b := 1, 1
+
+		for {
+			if !yield(a) {
+				return
+			}
+			a, b = b, a+b
+		}
+	}
+}
+
+func main() {
+	add := func(acc, v int) int { return acc + v }
+
+	// Нарастающая сумма конечной последовательности.
+	nums := []int{1, 2, 3, 4, 5}
+	fmt.Println(slices.Collect(Scan(slices.Values(nums), 0, add)))
+
+	// Аккумулятор может иметь другой тип, чем элементы:
+	// здесь из строк собирается всё более длинный путь.
+	parts := []string{"usr", "local", "bin"}
+	for p := range Scan(slices.Values(parts), "", func(acc, s string) string {
+		return acc + "/" + s
+	}) {
+		fmt.Println(p)
+	}
+
+	// Суммы чисел Фибоначчи: `genFib` бесконечен, поэтому
+	// останавливаемся сами, как только сумма превысит 50.
+	for s := range Scan(genFib(), 0, add) {
+		if s > 50 {
+			break
+		}
+		fmt.Println(s)
+	}
+}
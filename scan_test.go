@@ -0,0 +1,44 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func add(acc, v int) int { return acc + v }
+
+func TestScan(t *testing.T) {
+	got := slices.Collect(Scan(slices.Values([]int{1, 2, 3, 4, 5}), 0, add))
+	if want := []int{1, 3, 6, 10, 15}; !slices.Equal(got, want) {
+		t.Errorf("Scan() = %v; want %v", got, want)
+	}
+}
+
+func TestScanEmpty(t *testing.T) {
+	// init не выдаётся.
+	if got := slices.Collect(Scan(slices.Values([]int{}), 100, add)); len(got) != 0 {
+		t.Errorf("Scan(empty) = %v; want []", got)
+	}
+}
+
+func TestScanAccumulatorType(t *testing.T) {
+	got := slices.Collect(Scan(slices.Values([]string{"usr", "local", "bin"}), "",
+		func(acc, s string) string { return acc + "/" + s }))
+	want := []string{"/usr", "/usr/local", "/usr/local/bin"}
+	if !slices.Equal(got, want) {
+		t.Errorf("Scan() = %v; want %v", got, want)
+	}
+}
+
+func TestScanInfinite(t *testing.T) {
+	var got []int
+	for s := range Scan(genFib(), 0, add) {
+		if s > 50 {
+			break
+		}
+		got = append(got, s)
+	}
+	if want := []int{1, 2, 4, 7, 12, 20, 33}; !slices.Equal(got, want) {
+		t.Errorf("Scan(genFib()) = %v; want %v", got, want)
+	}
+}
@@ -0,0 +1,49 @@
+// Двоичный поиск находит в отсортированном срезе не только
+// сам элемент, но и место, куда его вставить, чтобы срез
+// остался отсортированным. Это место называют нижней
+// границей (lower bound).
+
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// SearchInsert возвращает наименьший индекс `i`, такой что
+// `s[i] >= target`, или `len(s)`, если все элементы меньше.
+// Если `target` уже есть в срезе, это индекс его первого
+// вхождения. Срез должен быть отсортирован по возрастанию;
+// поиск занимает O(log n). Тот же результат даёт первое
+// значение `slices.BinarySearch`.
+func SearchInsert[T cmp.Ordered](s []T, target T) int {
+	// Инвариант: все элементы левее `lo` меньше `target`,
+	// все элементы начиная с `hi` — не меньше.
+	lo, hi := 0, len(s)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1) // без переполнения при больших lo+hi
+		if s[mid] < target {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo
+}
+
+func main() {
+	s := []int{10, 20, 20, 30}
+
+	for _, target := range []int{5, 20, 25, 40} {
+		fmt.Println(target, "->", SearchInsert(s, target))
+	}
+
+	fmt.Println(SearchInsert([]string{}, "go"))
+
+	// Вставка по найденному индексу сохраняет порядок.
+	words := []string{"apple", "cherry", "peach"}
+	i := SearchInsert(words, "banana")
+	words = slices.Insert(words, i, "banana")
+	fmt.Println(words, slices.IsSorted(words))
+}
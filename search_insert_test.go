@@ -0,0 +1,40 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSearchInsert(t *testing.T) {
+	s := []int{10, 20, 20, 30}
+	var tests = []struct {
+		target, want int
+	}{
+		{5, 0},
+		{10, 0},
+		{15, 1},
+		// Первое вхождение среди повторов.
+		{20, 1},
+		{25, 3},
+		{30, 3},
+		{40, 4},
+	}
+	for _, tt := range tests {
+		if got := SearchInsert(s, tt.target); got != tt.want {
+			t.Errorf("SearchInsert(%v, %d) = %d; want %d", s, tt.target, got, tt.want)
+		}
+	}
+	if got := SearchInsert([]string{}, "go"); got != 0 {
+		t.Errorf(`SearchInsert([], "go") = %d; want 0`, got)
+	}
+}
+
+func TestSearchInsertMatchesBinarySearch(t *testing.T) {
+	s := []int{1, 1, 2, 3, 5, 8, 8, 8, 13}
+	for target := 0; target <= 14; target++ {
+		want, _ := slices.BinarySearch(s, target)
+		if got := SearchInsert(s, target); got != want {
+			t.Errorf("SearchInsert(%v, %d) = %d; want %d", s, target, got, want)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+// Объединим карты и обобщения: сравним два среза как множества
+// и найдём, какие элементы добавились, а какие пропали. Карта
+// с пустыми структурами в качестве значений — идиоматичное
+// множество в Go.
+
+package main
+
+import "fmt"
+
+// Diff возвращает элементы, которые есть только в `new` (`added`),
+// и элементы, которые есть только в `old` (`removed`). Срезы
+// сравниваются как множества: повторы учитываются один раз,
+// а результат упорядочен по первому появлению в исходном срезе.
+func Diff[T comparable](old, new []T) (added, removed []T) {
+	return onlyIn(new, old), onlyIn(old, new)
+}
+
+// onlyIn возвращает уникальные элементы `a`, которых нет в `b`.
+func onlyIn[T comparable](a, b []T) []T {
+	// `struct{}` не занимает памяти: нас интересуют только ключи.
+	inB := make(map[T]struct{}, len(b))
+	for _, v := range b {
+		inB[v] = struct{}{}
+	}
+
+	var out []T
+	seen := make(map[T]struct{})
+	for _, v := range a {
+		if _, ok := inB[v]; ok {
+			continue
+		}
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		out = append(out, v)
+	}
+	return out
+}
+
+func main() {
+	old := []string{"go", "rust", "c", "rust"}
+	new := []string{"go", "zig", "c", "zig", "odin"}
+
+	added, removed := Diff(old, new)
+	fmt.Println("added:", added)
+	fmt.Println("removed:", removed)
+
+	// Для одинаковых множеств оба результата пусты.
+	added2, removed2 := Diff([]int{1, 2, 3}, []int{3, 2, 1})
+	fmt.Println("same:", len(added2), len(removed2))
+}
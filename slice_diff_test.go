@@ -0,0 +1,37 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	var tests = []struct {
+		name                string
+		old, new            []string
+		wantAdded, wantRmvd []string
+	}{
+		{"mixed", []string{"go", "rust", "c", "rust"}, []string{"go", "zig", "c", "zig", "odin"},
+			[]string{"zig", "odin"}, []string{"rust"}},
+		{"same set", []string{"a", "b"}, []string{"b", "a", "a"}, nil, nil},
+		{"from empty", nil, []string{"a", "a", "b"}, []string{"a", "b"}, nil},
+		{"to empty", []string{"b", "a"}, nil, nil, []string{"b", "a"}},
+		{"both empty", nil, nil, nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			added, removed := Diff(tt.old, tt.new)
+			if !slices.Equal(added, tt.wantAdded) || !slices.Equal(removed, tt.wantRmvd) {
+				t.Errorf("Diff(%v, %v) = %v, %v; want %v, %v",
+					tt.old, tt.new, added, removed, tt.wantAdded, tt.wantRmvd)
+			}
+		})
+	}
+}
+
+func TestDiffInts(t *testing.T) {
+	added, removed := Diff([]int{1, 2, 3}, []int{3, 4, 1})
+	if !slices.Equal(added, []int{4}) || !slices.Equal(removed, []int{2}) {
+		t.Errorf("Diff() = %v, %v; want [4], [2]", added, removed)
+	}
+}
@@ -0,0 +1,75 @@
+// Максимум в скользящем окне: для каждого отрезка из `k`
+// подряд идущих элементов нужно найти наибольший. Наивно это
+// O(n·k), но с монотонной очередью (deque) выходит O(n).
+
+package main
+
+import (
+	"fmt"
+	"slices"
+)
+
+// MaxSlidingWindow возвращает максимумы всех окон размера `k`:
+// для среза длины n их n-k+1. Если `k <= 0` или `k` больше
+// длины среза, ни одного полного окна нет и результат `nil`.
+//
+// Очередь `dq` хранит индексы, значения по которым убывают.
+// Новый элемент вытесняет с хвоста все меньшие или равные: они
+// уже никогда не станут максимумом, потому что новый элемент
+// больше и останется в окне дольше. Поэтому максимум окна
+// всегда в голове очереди, а каждый индекс добавляется и
+// удаляется не более одного раза.
+func MaxSlidingWindow(nums []int, k int) []int {
+	if k <= 0 || k > len(nums) {
+		return nil
+	}
+
+	out := make([]int, 0, len(nums)-k+1)
+	var dq []int
+	for i, v := range nums {
+		// Голова вышла за левую границу окна.
+		if len(dq) > 0 && dq[0] <= i-k {
+			dq = dq[1:]
+		}
+		for len(dq) > 0 && nums[dq[len(dq)-1]] <= v {
+			dq = dq[:len(dq)-1]
+		}
+		dq = append(dq, i)
+
+		if i >= k-1 {
+			out = append(out, nums[dq[0]])
+		}
+	}
+	return out
+}
+
+// bruteForce считает то же самое «в лоб» — для сравнения.
+func bruteForce(nums []int, k int) []int {
+	if k <= 0 || k > len(nums) {
+		return nil
+	}
+	var out []int
+	for i := 0; i+k <= len(nums); i++ {
+		out = append(out, slices.Max(nums[i:i+k]))
+	}
+	return out
+}
+
+func main() {
+	nums := []int{1, 3, -1, -3, 5, 3, 6, 7}
+	fmt.Println(MaxSlidingWindow(nums, 3))
+
+	// Сверим с наивной версией на разных размерах окна,
+	// включая граничные.
+	ok := true
+	for k := -1; k <= len(nums)+1; k++ {
+		got, want := MaxSlidingWindow(nums, k), bruteForce(nums, k)
+		if !slices.Equal(got, want) {
+			fmt.Println("mismatch for k =", k, got, want)
+			ok = false
+		}
+	}
+	fmt.Println("matches brute force:", ok)
+
+	fmt.Println(MaxSlidingWindow(nums, 0), MaxSlidingWindow(nums, 9))
+}
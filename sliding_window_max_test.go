@@ -0,0 +1,56 @@
+package main
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestMaxSlidingWindow(t *testing.T) {
+	nums := []int{1, 3, -1, -3, 5, 3, 6, 7}
+	var tests = []struct {
+		k    int
+		want []int
+	}{
+		{3, []int{3, 3, 5, 5, 6, 7}},
+		{1, nums},
+		{8, []int{7}},
+		{0, nil},
+		{-1, nil},
+		{9, nil},
+	}
+	for _, tt := range tests {
+		if got := MaxSlidingWindow(nums, tt.k); !slices.Equal(got, tt.want) {
+			t.Errorf("MaxSlidingWindow(%v, %d) = %v; want %v", nums, tt.k, got, tt.want)
+		}
+	}
+}
+
+func TestMaxSlidingWindowMatchesBruteForce(t *testing.T) {
+	// Маленький диапазон значений даёт много повторов,
+	// на которых легко ошибиться при вытеснении из очереди.
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n <= 20; n++ {
+		nums := make([]int, n)
+		for i := range nums {
+			nums[i] = r.Intn(7) - 3
+		}
+		for k := -1; k <= n+1; k++ {
+			got, want := MaxSlidingWindow(nums, k), bruteForce(nums, k)
+			if !slices.Equal(got, want) {
+				t.Errorf("MaxSlidingWindow(%v, %d) = %v; want %v", nums, k, got, want)
+			}
+		}
+	}
+}
+
+func TestMaxSlidingWindowMonotonic(t *testing.T) {
+	inc := []int{1, 2, 3, 4, 5}
+	dec := []int{5, 4, 3, 2, 1}
+	if got := MaxSlidingWindow(inc, 2); !slices.Equal(got, []int{2, 3, 4, 5}) {
+		t.Errorf("MaxSlidingWindow(%v, 2) = %v; want [2 3 4 5]", inc, got)
+	}
+	if got := MaxSlidingWindow(dec, 2); !slices.Equal(got, []int{5, 4, 3, 2}) {
+		t.Errorf("MaxSlidingWindow(%v, 2) = %v; want [5 4 3 2]", dec, got)
+	}
+}
@@ -0,0 +1,145 @@
+// Перечисление `ServerState` из примера о перечислениях вместе
+// с функцией `transition` уже описывает конечный автомат. Обернём
+// его в тип `Machine`, который помнит историю состояний и умеет
+// сохранять её в байты и восстанавливать обратно.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Каждый пример — отдельная программа, поэтому повторим
+// здесь `ServerState` и `transition` из [примера о перечислениях](22_enums.go).
+type ServerState int
+
+const (
+	StateIdle ServerState = iota
+	StateConnected
+	StateError
+	StateRetrying
+)
+
+var stateName = map[ServerState]string{
+	StateIdle:      "idle",
+	StateConnected: "connected",
+	StateError:     "error",
+	StateRetrying:  "retrying",
+}
+
+func (ss ServerState) String() string {
+	return stateName[ss]
+}
+
+// parseState — обратное к `String` преобразование.
+func parseState(name string) (ServerState, error) {
+	for s, n := range stateName {
+		if n == name {
+			return s, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown state %q", name)
+}
+
+// Таблица переходов описывает те же правила, что и `switch`
+// в `transition` исходного примера, но в виде данных: по ней
+// можно не только сделать шаг, но и проверить чужую последовательность.
+var transitions = map[ServerState]ServerState{
+	StateIdle:      StateConnected,
+	StateConnected: StateIdle,
+	StateRetrying:  StateIdle,
+	StateError:     StateError,
+}
+
+func transition(s ServerState) ServerState {
+	next, ok := transitions[s]
+	if !ok {
+		panic(fmt.Errorf("unknown state: %s", s))
+	}
+	return next
+}
+
+// ValidateSequence проверяет, что каждая пара соседних состояний
+// в `states` — допустимый переход по таблице, и возвращает ошибку
+// для первого недопустимого шага.
+func ValidateSequence(states []ServerState) error {
+	for i := 1; i < len(states); i++ {
+		from, to := states[i-1], states[i]
+		if next, ok := transitions[from]; !ok || next != to {
+			return fmt.Errorf("step %d: illegal transition %s -> %s", i, from, to)
+		}
+	}
+	return nil
+}
+
+// `Machine` хранит текущее состояние и все предыдущие.
+type Machine struct {
+	state   ServerState
+	history []ServerState
+}
+
+func NewMachine(start ServerState) *Machine {
+	return &Machine{state: start}
+}
+
+// Step переводит автомат в следующее состояние.
+func (m *Machine) Step() {
+	m.history = append(m.history, m.state)
+	m.state = transition(m.state)
+}
+
+// Snapshot сохраняет историю и текущее состояние в виде имён
+// состояний через запятую: `idle,connected,idle`. Последнее имя —
+// текущее состояние. Строковое представление, в отличие от чисел
+// `iota`, не сломается, если порядок констант когда-нибудь изменится.
+func (m *Machine) Snapshot() []byte {
+	names := make([]string, 0, len(m.history)+1)
+	for _, s := range m.history {
+		names = append(names, s.String())
+	}
+	names = append(names, m.state.String())
+	return []byte(strings.Join(names, ","))
+}
+
+// RestoreMachine восстанавливает автомат из результата `Snapshot`.
+// Неизвестное имя состояния или пустые данные — ошибка.
+func RestoreMachine(data []byte) (*Machine, error) {
+	if len(data) == 0 {
+		return nil, errors.New("empty snapshot")
+	}
+	var states []ServerState
+	for _, name := range strings.Split(string(data), ",") {
+		s, err := parseState(name)
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, s)
+	}
+	last := len(states) - 1
+	return &Machine{state: states[last], history: states[:last]}, nil
+}
+
+func main() {
+	m := NewMachine(StateIdle)
+	m.Step()
+	m.Step()
+	m.Step()
+
+	data := m.Snapshot()
+	fmt.Println("snapshot:", string(data))
+
+	restored, err := RestoreMachine(data)
+	fmt.Println("restored:", restored.state, restored.history, err)
+	fmt.Println("equal:", restored.state == m.state && slices.Equal(restored.history, m.history))
+
+	_, err = RestoreMachine([]byte("idle,sleeping"))
+	fmt.Println("error:", err)
+
+	// История автомата всегда проходит проверку, а прыжок
+	// из `idle` сразу в `error` — нет.
+	fmt.Println("valid:", ValidateSequence(slices.Concat(m.history, []ServerState{m.state})))
+	fmt.Println("valid:", ValidateSequence([]ServerState{StateIdle, StateConnected, StateIdle, StateError}))
+}
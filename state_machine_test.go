@@ -0,0 +1,105 @@
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestMachineStep(t *testing.T) {
+	m := NewMachine(StateIdle)
+	m.Step()
+	m.Step()
+	m.Step()
+	if m.state != StateConnected {
+		t.Errorf("state = %v; want %v", m.state, StateConnected)
+	}
+	want := []ServerState{StateIdle, StateConnected, StateIdle}
+	if !slices.Equal(m.history, want) {
+		t.Errorf("history = %v; want %v", m.history, want)
+	}
+}
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	m := NewMachine(StateIdle)
+	m.Step()
+	m.Step()
+	m.Step()
+
+	data := m.Snapshot()
+	if got, want := string(data), "idle,connected,idle,connected"; got != want {
+		t.Fatalf("Snapshot() = %q; want %q", got, want)
+	}
+	r, err := RestoreMachine(data)
+	if err != nil {
+		t.Fatalf("RestoreMachine() error = %v", err)
+	}
+	if r.state != m.state || !slices.Equal(r.history, m.history) {
+		t.Errorf("restored = %v %v; want %v %v", r.state, r.history, m.state, m.history)
+	}
+}
+
+func TestSnapshotFresh(t *testing.T) {
+	// Без шагов снимок содержит только текущее состояние.
+	data := NewMachine(StateError).Snapshot()
+	if string(data) != "error" {
+		t.Fatalf("Snapshot() = %q; want %q", data, "error")
+	}
+	r, err := RestoreMachine(data)
+	if err != nil || r.state != StateError || len(r.history) != 0 {
+		t.Errorf("RestoreMachine(%q) = %v %v, %v; want error [], nil", data, r.state, r.history, err)
+	}
+}
+
+func TestRestoreMachineErrors(t *testing.T) {
+	for _, data := range []string{"", "idle,sleeping", "idle,", "Idle"} {
+		if _, err := RestoreMachine([]byte(data)); err == nil {
+			t.Errorf("RestoreMachine(%q) error = nil; want error", data)
+		}
+	}
+}
+
+func TestValidateSequence(t *testing.T) {
+	var tests = []struct {
+		name    string
+		states  []ServerState
+		wantErr string
+	}{
+		{"empty", nil, ""},
+		{"single", []ServerState{StateError}, ""},
+		{"idle loop", []ServerState{StateIdle, StateConnected, StateIdle}, ""},
+		{"retrying", []ServerState{StateRetrying, StateIdle, StateConnected}, ""},
+		{"error stays", []ServerState{StateError, StateError}, ""},
+		{"jump", []ServerState{StateIdle, StateConnected, StateIdle, StateError}, "step 3: illegal transition idle -> error"},
+		{"first step", []ServerState{StateConnected, StateConnected}, "step 1: illegal transition connected -> connected"},
+		{"unknown", []ServerState{ServerState(42), StateIdle}, "step 1: illegal transition"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSequence(tt.states)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateSequence(%v) = %v; want nil", tt.states, err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateSequence(%v) = %v; want %q", tt.states, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateSequenceAcceptsHistory(t *testing.T) {
+	// История, пройденная автоматом, всегда допустима.
+	for start := range stateName {
+		m := NewMachine(start)
+		for i := 0; i < 5; i++ {
+			m.Step()
+		}
+		seq := append(slices.Clone(m.history), m.state)
+		if err := ValidateSequence(seq); err != nil {
+			t.Errorf("ValidateSequence(%v) = %v; want nil", seq, err)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// Секундомер — небольшая структура поверх пакета `time`:
+// его можно запускать, останавливать и снова запускать,
+// а накопленное время складывается.
+
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+// `Stopwatch` хранит время, накопленное в прошлых запусках,
+// и момент начала текущего запуска. Текущее время берётся из
+// `now`, как в `CircuitBreaker`, чтобы часы можно было подменить.
+// Нулевое значение готово к работе: без подменённых часов
+// используется `time.Now`.
+type Stopwatch struct {
+	now     func() time.Time
+	running bool
+	started time.Time
+	total   time.Duration
+}
+
+func NewStopwatch() *Stopwatch {
+	return &Stopwatch{now: time.Now}
+}
+
+// clock возвращает текущее время по часам секундомера.
+func (s *Stopwatch) clock() time.Time {
+	if s.now == nil {
+		return time.Now()
+	}
+	return s.now()
+}
+
+// Start запускает секундомер. Повторный запуск уже идущего
+// секундомера ничего не делает.
+func (s *Stopwatch) Start() {
+	if s.running {
+		return
+	}
+	s.running = true
+	s.started = s.clock()
+}
+
+// Stop останавливает секундомер и возвращает всё накопленное время.
+func (s *Stopwatch) Stop() time.Duration {
+	if s.running {
+		s.total += s.clock().Sub(s.started)
+		s.running = false
+	}
+	return s.total
+}
+
+// Elapsed возвращает накопленное время, включая текущий
+// запуск, не останавливая секундомер.
+func (s *Stopwatch) Elapsed() time.Duration {
+	if s.running {
+		return s.total + s.clock().Sub(s.started)
+	}
+	return s.total
+}
+
+// Reset обнуляет секундомер и останавливает его.
+func (s *Stopwatch) Reset() {
+	s.running = false
+	s.total = 0
+}
+
+func main() {
+	// Поддельные часы, которые идут только вручную: так вывод
+	// примера не зависит от скорости машины.
+	clock := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	sw := NewStopwatch()
+	sw.now = func() time.Time { return clock }
+
+	sw.Start()
+	clock = clock.Add(2 * time.Second)
+	fmt.Println("elapsed:", sw.Elapsed())
+
+	clock = clock.Add(time.Second)
+	fmt.Println("stop:", sw.Stop())
+
+	// Пока секундомер стоит, время не идёт.
+	clock = clock.Add(time.Minute)
+	fmt.Println("paused:", sw.Elapsed())
+
+	sw.Start()
+	clock = clock.Add(500 * time.Millisecond)
+	fmt.Println("stop:", sw.Stop())
+
+	sw.Reset()
+	fmt.Println("reset:", sw.Elapsed())
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+// fakeStopwatch возвращает секундомер на поддельных часах и
+// указатель на их текущее время.
+func fakeStopwatch() (*Stopwatch, *time.Time) {
+	clock := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	sw := NewStopwatch()
+	sw.now = func() time.Time { return clock }
+	return sw, &clock
+}
+
+func TestStopwatchStartStopElapsed(t *testing.T) {
+	sw, clock := fakeStopwatch()
+
+	sw.Start()
+	*clock = clock.Add(2 * time.Second)
+	if got := sw.Elapsed(); got != 2*time.Second {
+		t.Errorf("Elapsed while running = %v; want 2s", got)
+	}
+
+	*clock = clock.Add(time.Second)
+	if got := sw.Stop(); got != 3*time.Second {
+		t.Errorf("Stop = %v; want 3s", got)
+	}
+
+	// Пока секундомер стоит, время не идёт.
+	*clock = clock.Add(time.Minute)
+	if got := sw.Elapsed(); got != 3*time.Second {
+		t.Errorf("Elapsed while stopped = %v; want 3s", got)
+	}
+
+	// Повторный запуск добавляет к накопленному времени, а
+	// повторный `Start` на идущем секундомере ничего не меняет.
+	sw.Start()
+	*clock = clock.Add(250 * time.Millisecond)
+	sw.Start()
+	*clock = clock.Add(250 * time.Millisecond)
+	if got := sw.Stop(); got != 3500*time.Millisecond {
+		t.Errorf("Stop after restart = %v; want 3.5s", got)
+	}
+}
+
+func TestStopwatchReset(t *testing.T) {
+	sw, clock := fakeStopwatch()
+
+	sw.Start()
+	*clock = clock.Add(time.Second)
+	sw.Reset()
+	if got := sw.Elapsed(); got != 0 {
+		t.Errorf("Elapsed after Reset = %v; want 0", got)
+	}
+
+	// После сброса секундомер остановлен.
+	*clock = clock.Add(time.Second)
+	if got := sw.Stop(); got != 0 {
+		t.Errorf("Stop after Reset = %v; want 0", got)
+	}
+}
+
+func TestStopwatchZeroValue(t *testing.T) {
+	var sw Stopwatch
+	sw.Start()
+	if got := sw.Stop(); got < 0 {
+		t.Errorf("Stop = %v; want a non-negative duration", got)
+	}
+}
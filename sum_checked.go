@@ -0,0 +1,50 @@
+// Целые числа в Go переполняются молча: `math.MaxInt + 1`
+// превращается в `math.MinInt` без всякой ошибки. Вариативная
+// `sum` из [примера](13_variadic_functions.go) ничего не знает
+// об этом; здесь мы проверяем каждое сложение заранее.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var ErrOverflow = errors.New("integer overflow")
+
+// SumChecked складывает `nums` и возвращает `ErrOverflow`, если
+// промежуточная сумма выходит за пределы `int`. Сложение
+// `total + n` переполняется, только если `n > 0` и
+// `total > math.MaxInt - n` или `n < 0` и `total < math.MinInt - n`;
+// сами эти проверки переполниться не могут.
+func SumChecked(nums ...int) (int, error) {
+	total := 0
+	for _, n := range nums {
+		if n > 0 && total > math.MaxInt-n ||
+			n < 0 && total < math.MinInt-n {
+			return 0, fmt.Errorf("sum %d + %d: %w", total, n, ErrOverflow)
+		}
+		total += n
+	}
+	return total, nil
+}
+
+func main() {
+	fmt.Println(SumChecked(1, 2, 3, 4))
+	fmt.Println(SumChecked())
+
+	// Молчаливое переполнение обычного сложения.
+	big := math.MaxInt
+	fmt.Println(big+1 == math.MinInt)
+
+	_, err := SumChecked(math.MaxInt-1, 1, 1)
+	fmt.Println(err, errors.Is(err, ErrOverflow))
+
+	_, err = SumChecked(math.MinInt, -1)
+	fmt.Println(err)
+
+	// Проверяются промежуточные суммы, а не слагаемые: огромные
+	// числа разных знаков компенсируют друг друга без ошибки.
+	fmt.Println(SumChecked(math.MaxInt, math.MinInt, 1))
+}
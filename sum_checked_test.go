@@ -0,0 +1,43 @@
+package main
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestSumChecked(t *testing.T) {
+	var tests = []struct {
+		nums []int
+		want int
+	}{
+		{nil, 0},
+		{[]int{1, 2, 3, 4}, 10},
+		{[]int{-5, 3}, -2},
+		{[]int{math.MaxInt}, math.MaxInt},
+		{[]int{math.MaxInt - 1, 1}, math.MaxInt},
+		{[]int{math.MinInt + 1, -1}, math.MinInt},
+		// Проверяются промежуточные суммы, а не слагаемые.
+		{[]int{math.MaxInt, math.MinInt, 1}, 0},
+	}
+	for _, tt := range tests {
+		got, err := SumChecked(tt.nums...)
+		if err != nil || got != tt.want {
+			t.Errorf("SumChecked(%v) = %d, %v; want %d, nil", tt.nums, got, err, tt.want)
+		}
+	}
+}
+
+func TestSumCheckedOverflow(t *testing.T) {
+	for _, nums := range [][]int{
+		{math.MaxInt, 1},
+		{math.MaxInt - 1, 1, 1},
+		{math.MinInt, -1},
+		{math.MinInt / 2, math.MinInt/2 - 1},
+	} {
+		got, err := SumChecked(nums...)
+		if !errors.Is(err, ErrOverflow) || got != 0 {
+			t.Errorf("SumChecked(%v) = %d, %v; want 0, %v", nums, got, err, ErrOverflow)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+// _Троттлинг_ (throttle) ограничивает частоту вызовов функции:
+// она выполняется не чаще одного раза за интервал, а вызовы
+// внутри интервала просто отбрасываются. В отличие от
+// устранения дребезга, первый вызов срабатывает сразу.
+
+package main
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Текущее время берётся из переменной `now`, чтобы тест
+// (или этот пример) мог подставить собственные часы.
+var now = time.Now
+
+// Throttled возвращает функцию, которая вызывает `fn`, только
+// если с предыдущего _выполненного_ вызова прошло не меньше
+// `minInterval`. Остальные вызовы отбрасываются, а не
+// откладываются. Возвращённую функцию можно вызывать из
+// разных горутин.
+func Throttled(minInterval time.Duration, fn func()) func() {
+	var mu sync.Mutex
+	var last time.Time
+	called := false
+
+	return func() {
+		mu.Lock()
+		t := now()
+		if called && t.Sub(last) < minInterval {
+			mu.Unlock()
+			return
+		}
+		called = true
+		last = t
+		mu.Unlock()
+		fn()
+	}
+}
+
+func main() {
+	// Поддельные часы, которые идут только вручную.
+	clock := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	now = func() time.Time { return clock }
+
+	count := 0
+	ping := Throttled(time.Second, func() { count++ })
+
+	// Вызовы каждые 300мс: срабатывают только те, что
+	// отстоят от последнего выполненного хотя бы на секунду.
+	for i := 0; i < 8; i++ {
+		before := count
+		ping()
+		fmt.Printf("t=%v fired=%v\n", time.Duration(i)*300*time.Millisecond, count > before)
+		clock = clock.Add(300 * time.Millisecond)
+	}
+	fmt.Println("total:", count)
+}
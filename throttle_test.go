@@ -0,0 +1,62 @@
+package main
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+// useFakeClock подменяет `now` на время теста и возвращает
+// указатель на текущее время поддельных часов.
+func useFakeClock(t *testing.T) *time.Time {
+	clock := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	orig := now
+	now = func() time.Time { return clock }
+	t.Cleanup(func() { now = orig })
+	return &clock
+}
+
+func TestThrottledSuppressesWithinInterval(t *testing.T) {
+	clock := useFakeClock(t)
+
+	// Записываем смещение каждого выполненного вызова от начала.
+	start := *clock
+	var fired []time.Duration
+	ping := Throttled(time.Second, func() {
+		fired = append(fired, clock.Sub(start))
+	})
+
+	for i := 0; i < 8; i++ {
+		ping()
+		*clock = clock.Add(300 * time.Millisecond)
+	}
+
+	// Вызовы в 0, 300мс, ..., 2.1с: выполняются первый и те, что
+	// отстоят от последнего выполненного хотя бы на секунду.
+	want := []time.Duration{0, 1200 * time.Millisecond}
+	if !slices.Equal(fired, want) {
+		t.Errorf("fired at %v; want %v", fired, want)
+	}
+}
+
+func TestThrottledExactInterval(t *testing.T) {
+	clock := useFakeClock(t)
+
+	calls := 0
+	ping := Throttled(time.Second, func() { calls++ })
+
+	ping()
+	*clock = clock.Add(time.Second - time.Nanosecond)
+	ping()
+	if calls != 1 {
+		t.Fatalf("calls = %d before the interval elapsed; want 1", calls)
+	}
+
+	// Ровно через `minInterval` после выполненного вызова
+	// функция снова срабатывает.
+	*clock = clock.Add(time.Nanosecond)
+	ping()
+	if calls != 2 {
+		t.Errorf("calls = %d after the interval; want 2", calls)
+	}
+}
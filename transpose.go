@@ -0,0 +1,60 @@
+// Транспонирование превращает строки матрицы в столбцы:
+// элемент [i][j] переходит на место [j][i]. Функция обобщённая —
+// матрица может состоять из чисел, строк или чего угодно.
+
+package main
+
+import "fmt"
+
+// Transpose возвращает новую матрицу, транспонированную к `m`;
+// сама `m` не меняется. Для пустой матрицы результат пустой.
+//
+// Матрица должна быть прямоугольной. У «рваной» матрицы, где
+// строки разной длины, транспонирования нет, и `Transpose`
+// паникует, как при выходе за границы среза: это ошибка
+// вызывающего кода, а не ситуация, которую стоит обрабатывать.
+// Если ввод приходит извне, проверьте длины строк заранее.
+func Transpose[T any](m [][]T) [][]T {
+	if len(m) == 0 {
+		return [][]T{}
+	}
+	cols := len(m[0])
+	for i, row := range m {
+		if len(row) != cols {
+			panic(fmt.Sprintf("Transpose: row %d has %d columns, want %d",
+				i, len(row), cols))
+		}
+	}
+
+	t := make([][]T, cols)
+	for j := range t {
+		t[j] = make([]T, len(m))
+		for i := range m {
+			t[j][i] = m[i][j]
+		}
+	}
+	return t
+}
+
+func main() {
+	// Квадратная матрица отражается относительно диагонали.
+	fmt.Println(Transpose([][]int{
+		{1, 2},
+		{3, 4},
+	}))
+
+	// Матрица 2×3 становится матрицей 3×2.
+	fmt.Println(Transpose([][]string{
+		{"a", "b", "c"},
+		{"d", "e", "f"},
+	}))
+
+	fmt.Println(Transpose([][]int{}))
+
+	// Рваная матрица приводит к панике; перехватим её, как в
+	// [примере про recover](50_recover.go).
+	defer func() {
+		fmt.Println("recovered:", recover())
+	}()
+	Transpose([][]int{{1, 2}, {3}})
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTranspose(t *testing.T) {
+	var tests = []struct {
+		name string
+		m    [][]int
+		want [][]int
+	}{
+		{"square", [][]int{{1, 2}, {3, 4}}, [][]int{{1, 3}, {2, 4}}},
+		{"2x3", [][]int{{1, 2, 3}, {4, 5, 6}}, [][]int{{1, 4}, {2, 5}, {3, 6}}},
+		{"row", [][]int{{1, 2, 3}}, [][]int{{1}, {2}, {3}}},
+		{"empty", [][]int{}, [][]int{}},
+		{"nil", nil, [][]int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Transpose(tt.m); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Transpose(%v) = %v; want %v", tt.m, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransposeDoesNotModify(t *testing.T) {
+	m := [][]string{{"a", "b"}, {"c", "d"}}
+	Transpose(m)[0][1] = "x"
+	if want := [][]string{{"a", "b"}, {"c", "d"}}; !reflect.DeepEqual(m, want) {
+		t.Errorf("m = %v after Transpose; want %v", m, want)
+	}
+}
+
+func TestTransposeJaggedPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Transpose(jagged) did not panic")
+		}
+	}()
+	Transpose([][]int{{1, 2}, {3}})
+}
@@ -0,0 +1,84 @@
+// [_Префиксное дерево_](https://en.wikipedia.org/wiki/Trie) (trie)
+// хранит набор строк так, что слова с общим началом делят общий
+// путь от корня. Это позволяет быстро проверять как наличие слова,
+// так и наличие слов с заданным префиксом.
+
+package main
+
+import "fmt"
+
+// Каждый узел `Trie` — это карта от руны к следующему узлу.
+// Ключи имеют тип `rune`, а не `byte`, поэтому многобайтовые
+// символы UTF-8 (см. пример о строках и рунах) занимают ровно
+// один уровень дерева. Флаг `end` отмечает, что в этом узле
+// заканчивается вставленное слово.
+type Trie struct {
+	children map[rune]*Trie
+	end      bool
+}
+
+// Insert добавляет слово в дерево, создавая недостающие узлы.
+func (t *Trie) Insert(word string) {
+	node := t
+	// `range` по строке декодирует её по рунам.
+	for _, r := range word {
+		if node.children == nil {
+			node.children = make(map[rune]*Trie)
+		}
+		next, ok := node.children[r]
+		if !ok {
+			next = &Trie{}
+			node.children[r] = next
+		}
+		node = next
+	}
+	node.end = true
+}
+
+// find спускается по дереву вдоль `s` и возвращает узел,
+// в котором заканчивается путь, или `nil`, если пути нет.
+func (t *Trie) find(s string) *Trie {
+	node := t
+	for _, r := range s {
+		// Чтение из `nil`-карты безопасно и возвращает нулевое значение.
+		node = node.children[r]
+		if node == nil {
+			return nil
+		}
+	}
+	return node
+}
+
+// Contains сообщает, было ли слово вставлено целиком.
+func (t *Trie) Contains(word string) bool {
+	node := t.find(word)
+	return node != nil && node.end
+}
+
+// HasPrefix сообщает, начинается ли с `prefix` хотя бы одно
+// вставленное слово. Узлы создаются только вдоль вставленных
+// слов, поэтому под любым найденным узлом есть слово — кроме
+// корня пустого дерева. Его приходится проверять отдельно,
+// иначе `HasPrefix("")` возвращал бы `true` для пустого дерева.
+func (t *Trie) HasPrefix(prefix string) bool {
+	node := t.find(prefix)
+	return node != nil && (node.end || len(node.children) > 0)
+}
+
+func main() {
+	var t Trie
+	t.Insert("go")
+	t.Insert("gopher")
+	t.Insert("привет")
+
+	fmt.Println("contains go:", t.Contains("go"))
+	fmt.Println("contains goph:", t.Contains("goph"))
+	fmt.Println("prefix goph:", t.HasPrefix("goph"))
+	fmt.Println("prefix rust:", t.HasPrefix("rust"))
+
+	// Кириллические буквы занимают по два байта, но
+	// в дереве каждая из них — один узел.
+	fmt.Println("contains привет:", t.Contains("привет"))
+	fmt.Println("prefix при:", t.HasPrefix("при"))
+	fmt.Println("contains пока:", t.Contains("пока"))
+}
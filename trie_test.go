@@ -0,0 +1,73 @@
+package main
+
+import "testing"
+
+func newTestTrie() *Trie {
+	var t Trie
+	for _, w := range []string{"go", "gopher", "привет"} {
+		t.Insert(w)
+	}
+	return &t
+}
+
+func TestTrieContains(t *testing.T) {
+	trie := newTestTrie()
+	var tests = []struct {
+		word string
+		want bool
+	}{
+		{"go", true},
+		{"gopher", true},
+		{"привет", true},
+		{"goph", false},
+		{"g", false},
+		{"gophers", false},
+		{"при", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := trie.Contains(tt.word); got != tt.want {
+			t.Errorf("Contains(%q) = %v; want %v", tt.word, got, tt.want)
+		}
+	}
+}
+
+func TestTrieHasPrefix(t *testing.T) {
+	trie := newTestTrie()
+	var tests = []struct {
+		prefix string
+		want   bool
+	}{
+		{"", true},
+		{"g", true},
+		{"goph", true},
+		{"gopher", true},
+		{"gophers", false},
+		{"rust", false},
+		// Кириллическая руна — один узел, а не два байта.
+		{"п", true},
+		{"при", true},
+		{"пока", false},
+	}
+	for _, tt := range tests {
+		if got := trie.HasPrefix(tt.prefix); got != tt.want {
+			t.Errorf("HasPrefix(%q) = %v; want %v", tt.prefix, got, tt.want)
+		}
+	}
+}
+
+func TestTrieEmpty(t *testing.T) {
+	var trie Trie
+	if trie.HasPrefix("") {
+		t.Error(`HasPrefix("") = true on empty trie`)
+	}
+	if trie.Contains("") {
+		t.Error(`Contains("") = true on empty trie`)
+	}
+
+	// Пустое слово можно вставить явно.
+	trie.Insert("")
+	if !trie.Contains("") || !trie.HasPrefix("") {
+		t.Error(`after Insert(""): Contains("") and HasPrefix("") should be true`)
+	}
+}
@@ -0,0 +1,39 @@
+// Классическая задача: найти в срезе два числа, сумма которых
+// равна заданной. Перебор всех пар занимает O(n²), а карта
+// «значение → индекс» позволяет решить её за один проход.
+
+package main
+
+import "fmt"
+
+// TwoSum возвращает индексы `i < j` двух элементов `nums`,
+// сумма которых равна `target`, и `found == true`. Для каждого
+// числа мы проверяем, не встречалось ли раньше его «дополнение»
+// `target - n`; если нет — запоминаем число. Если подходящих
+// пар несколько, возвращается та, чей второй элемент стоит
+// раньше всех. Если пары нет, возвращаются `0, 0, false`.
+func TwoSum(nums []int, target int) (i, j int, found bool) {
+	seen := make(map[int]int, len(nums))
+	for k, n := range nums {
+		if prev, ok := seen[target-n]; ok {
+			return prev, k, true
+		}
+		seen[n] = k
+	}
+	return 0, 0, false
+}
+
+func main() {
+	// Пара в самом начале.
+	fmt.Println(TwoSum([]int{2, 7, 11, 15}, 9))
+
+	// Пара в конце.
+	fmt.Println(TwoSum([]int{1, 3, 5, 8, 13}, 21))
+
+	// Одно и то же число можно взять дважды, только если
+	// оно встречается в срезе дважды.
+	fmt.Println(TwoSum([]int{3, 4}, 6))
+	fmt.Println(TwoSum([]int{3, 4, 3}, 6))
+
+	fmt.Println(TwoSum([]int{1, 2, 3}, 100))
+}
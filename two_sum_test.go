@@ -0,0 +1,33 @@
+package main
+
+import "testing"
+
+func TestTwoSum(t *testing.T) {
+	var tests = []struct {
+		nums   []int
+		target int
+		i, j   int
+		found  bool
+	}{
+		{[]int{2, 7, 11, 15}, 9, 0, 1, true},
+		{[]int{1, 3, 5, 8, 13}, 21, 3, 4, true},
+		{[]int{3, 4}, 6, 0, 0, false},
+		{[]int{3, 4, 3}, 6, 0, 2, true},
+		{[]int{-3, 4, 3, 90}, 0, 0, 2, true},
+		// Несколько пар: побеждает та, чей второй элемент раньше.
+		{[]int{1, 5, 4, 2}, 6, 0, 1, true},
+		{[]int{1, 4, 2, 5}, 6, 1, 2, true},
+		{[]int{1, 2, 3}, 100, 0, 0, false},
+		{nil, 0, 0, 0, false},
+	}
+	for _, tt := range tests {
+		i, j, found := TwoSum(tt.nums, tt.target)
+		if i != tt.i || j != tt.j || found != tt.found {
+			t.Errorf("TwoSum(%v, %d) = %d, %d, %v; want %d, %d, %v",
+				tt.nums, tt.target, i, j, found, tt.i, tt.j, tt.found)
+		}
+		if found && tt.nums[i]+tt.nums[j] != tt.target {
+			t.Errorf("TwoSum(%v, %d): nums[%d]+nums[%d] != %d", tt.nums, tt.target, i, j, tt.target)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+// Итераторы удобны не только для обхода коллекций. Здесь
+// итератор выполняет работу над срезом по шагам и после каждого
+// шага сообщает, какая доля работы сделана, — этого достаточно,
+// чтобы нарисовать индикатор прогресса.
+
+package main
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// WithProgress возвращает итератор, который вызывает `fn` для
+// очередного элемента `s` и выдаёт долю обработанных элементов
+// от 0 до 1. Работа ленивая: следующий элемент обрабатывается,
+// только когда потребитель просит следующее значение, а `break`
+// в цикле `range` останавливает обработку. Для пустого среза
+// итератор сразу выдаёт 1.0 — делать нечего, работа завершена.
+func WithProgress[T any](s []T, fn func(T)) iter.Seq[float64] {
+	return func(yield func(float64) bool) {
+		if len(s) == 0 {
+			yield(1.0)
+			return
+		}
+		for i, v := range s {
+			fn(v)
+			if !yield(float64(i+1) / float64(len(s))) {
+				return
+			}
+		}
+	}
+}
+
+func main() {
+	files := []string{"a.txt", "b.txt", "c.txt", "d.txt"}
+
+	var processed []string
+	for p := range WithProgress(files, func(f string) {
+		processed = append(processed, f)
+	}) {
+		bar := strings.Repeat("#", int(p*10))
+		fmt.Printf("[%-10s] %3.0f%%\n", bar, p*100)
+	}
+	fmt.Println("processed:", processed)
+}
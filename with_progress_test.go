@@ -0,0 +1,44 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestWithProgress(t *testing.T) {
+	var processed []int
+	var got []float64
+	for p := range WithProgress([]int{1, 2, 3, 4}, func(v int) {
+		processed = append(processed, v)
+	}) {
+		got = append(got, p)
+	}
+	if want := []float64{0.25, 0.5, 0.75, 1}; !slices.Equal(got, want) {
+		t.Errorf("progress = %v; want %v", got, want)
+	}
+	if want := []int{1, 2, 3, 4}; !slices.Equal(processed, want) {
+		t.Errorf("processed = %v; want %v", processed, want)
+	}
+}
+
+func TestWithProgressEmpty(t *testing.T) {
+	got := slices.Collect(WithProgress([]int{}, func(int) {
+		t.Error("fn called for empty slice")
+	}))
+	if want := []float64{1}; !slices.Equal(got, want) {
+		t.Errorf("progress = %v; want %v", got, want)
+	}
+}
+
+func TestWithProgressBreak(t *testing.T) {
+	// Работа ленивая: после break элементы не обрабатываются.
+	calls := 0
+	for p := range WithProgress([]int{1, 2, 3, 4}, func(int) { calls++ }) {
+		if p >= 0.5 {
+			break
+		}
+	}
+	if calls != 2 {
+		t.Errorf("fn called %d times; want 2", calls)
+	}
+}
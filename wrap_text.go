@@ -0,0 +1,54 @@
+// Перенос текста по ширине: разобьём строку на строки не
+// длиннее заданного числа символов, перенося целые слова.
+// Ширину считаем в рунах, а не в байтах, иначе кириллица
+// переносилась бы вдвое раньше латиницы.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// WrapText разбивает `s` на строки длиной не больше `width` рун,
+// перенося по пробелам. Последовательности пробелов схлопываются.
+// Слово длиннее `width` не разрезается, а занимает отдельную
+// строку целиком — такая строка будет длиннее `width`. При
+// `width < 1` каждое слово попадает на свою строку.
+func WrapText(s string, width int) []string {
+	var lines []string
+	var line strings.Builder
+	lineLen := 0
+
+	// `strings.Fields` делит строку по пробельным символам.
+	for _, word := range strings.Fields(s) {
+		wordLen := utf8.RuneCountInString(word)
+		if lineLen > 0 && lineLen+1+wordLen > width {
+			lines = append(lines, line.String())
+			line.Reset()
+			lineLen = 0
+		}
+		if lineLen > 0 {
+			line.WriteByte(' ')
+			lineLen++
+		}
+		line.WriteString(word)
+		lineLen += wordLen
+	}
+	if lineLen > 0 {
+		lines = append(lines, line.String())
+	}
+	return lines
+}
+
+func main() {
+	fmt.Printf("%q\n", WrapText("short text", 20))
+
+	text := "Горутины — это легковесные потоки выполнения, управляемые рантаймом Go."
+	for _, l := range WrapText(text, 24) {
+		fmt.Printf("|%-24s|\n", l)
+	}
+
+	fmt.Printf("%q\n", WrapText("a supercalifragilistic word", 10))
+}
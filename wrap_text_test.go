@@ -0,0 +1,42 @@
+package main
+
+import (
+	"slices"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestWrapText(t *testing.T) {
+	var tests = []struct {
+		s     string
+		width int
+		want  []string
+	}{
+		{"short text", 20, []string{"short text"}},
+		{"one two three four", 9, []string{"one two", "three", "four"}},
+		// Ровно `width` символов помещается в строку.
+		{"abc def", 7, []string{"abc def"}},
+		{"abc def", 6, []string{"abc", "def"}},
+		{"  many   spaces\n\there  ", 40, []string{"many spaces here"}},
+		{"a supercalifragilistic word", 10, []string{"a", "supercalifragilistic", "word"}},
+		{"a b", 0, []string{"a", "b"}},
+		{"", 10, nil},
+		{"   ", 10, nil},
+		// Ширина считается в рунах: "привет мир" — десять рун.
+		{"привет мир", 10, []string{"привет мир"}},
+	}
+	for _, tt := range tests {
+		if got := WrapText(tt.s, tt.width); !slices.Equal(got, tt.want) {
+			t.Errorf("WrapText(%q, %d) = %q; want %q", tt.s, tt.width, got, tt.want)
+		}
+	}
+}
+
+func TestWrapTextWidth(t *testing.T) {
+	text := "Горутины — это легковесные потоки выполнения, управляемые рантаймом Go."
+	for _, l := range WrapText(text, 24) {
+		if n := utf8.RuneCountInString(l); n > 24 {
+			t.Errorf("line %q has %d runes; want at most 24", l, n)
+		}
+	}
+}